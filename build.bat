@@ -1,30 +0,0 @@
-@echo off
-C:\Users\Paulino\go\pkg\mod\github.com\ethereum\go-ethereum@v1.10.4\build\bin\abigen.exe --bin=C:\Users\Paulino\go\src\github.com\nikola43\web3manager\contracts\build\IERC20.bin --abi=C:\Users\Paulino\go\src\g
-ithub.com\nikola43\web3manager\contracts\build\IERC20.abi --pkg=pancake --out=IERC20.go
-
-C:\Users\Paulino\go\pkg\mod\github.com\ethereum\go-ethereum@v1.10.4\build\bin\abigen.exe --bin=C:\Users\Paulino\go\src\github.com\nikola43\web3manager\contracts\build\IPancakeFactory.bin --abi=C:\Users\Paulino\go\src\g
-ithub.com\nikola43\web3manager\contracts\build\IPancakeFactory.abi --pkg=pancake --out=IPancakeFactory.go
-
-C:\Users\Paulino\go\pkg\mod\github.com\ethereum\go-ethereum@v1.10.4\build\bin\abigen.exe --bin=C:\Users\Paulino\go\src\github.com\nikola43\web3manager\contracts\build\IPancakePair.bin --abi=C:\Users\Paulino\go\src\g
-ithub.com\nikola43\web3manager\contracts\build\IPancakePair.abi --pkg=pancake --out=IPancakePair.go
-
-C:\Users\Paulino\go\pkg\mod\github.com\ethereum\go-ethereum@v1.10.4\build\bin\abigen.exe --bin=C:\Users\Paulino\go\src\github.com\nikola43\web3manager\contracts\build\IPancakeRouter01.bin --abi=C:\Users\Paulino\go\src\g
-ithub.com\nikola43\web3manager\contracts\build\IPancakeRouter01.abi --pkg=pancake --out=IPancakeRouter01.go
-
-C:\Users\Paulino\go\pkg\mod\github.com\ethereum\go-ethereum@v1.10.4\build\bin\abigen.exe --bin=C:\Users\Paulino\go\src\github.com\nikola43\web3manager\contracts\build\IPancakeRouter02.bin --abi=C:\Users\Paulino\go\src\g
-ithub.com\nikola43\web3manager\contracts\build\IPancakeRouter02.abi --pkg=pancake --out=IPancakeRouter02.go
-
-C:\Users\Paulino\go\pkg\mod\github.com\ethereum\go-ethereum@v1.10.4\build\bin\abigen.exe --bin=C:\Users\Paulino\go\src\github.com\nikola43\web3manager\contracts\build\IWETH.bin --abi=C:\Users\Paulino\go\src\g
-ithub.com\nikola43\web3manager\contracts\build\IWETH.abi --pkg=pancake --out=IWETH.go
-
-C:\Users\Paulino\go\pkg\mod\github.com\ethereum\go-ethereum@v1.10.4\build\bin\abigen.exe --bin=C:\Users\Paulino\go\src\github.com\nikola43\web3manager\contracts\build\PancakeLibrary.bin --abi=C:\Users\Paulino\go\src\g
-ithub.com\nikola43\web3manager\contracts\build\PancakeLibrary.abi --pkg=pancake --out=PancakeLibrary.go
-
-C:\Users\Paulino\go\pkg\mod\github.com\ethereum\go-ethereum@v1.10.4\build\bin\abigen.exe --bin=C:\Users\Paulino\go\src\github.com\nikola43\web3manager\contracts\build\PancakeRouter.bin --abi=C:\Users\Paulino\go\src\g
-ithub.com\nikola43\web3manager\contracts\build\PancakeRouter.abi --pkg=pancake --out=PancakeRouter.go
-
-C:\Users\Paulino\go\pkg\mod\github.com\ethereum\go-ethereum@v1.10.4\build\bin\abigen.exe --bin=C:\Users\Paulino\go\src\github.com\nikola43\web3manager\contracts\build\SafeMath.bin --abi=C:\Users\Paulino\go\src\g
-ithub.com\nikola43\web3manager\contracts\build\SafeMath.abi --pkg=pancake --out=SafeMath.go
-
-C:\Users\Paulino\go\pkg\mod\github.com\ethereum\go-ethereum@v1.10.4\build\bin\abigen.exe --bin=C:\Users\Paulino\go\src\github.com\nikola43\web3manager\contracts\build\TransferHelper.bin --abi=C:\Users\Paulino\go\src\g
-ithub.com\nikola43\web3manager\contracts\build\TransferHelper.abi --pkg=pancake --out=TransferHelper.go
@@ -0,0 +1,221 @@
+// Command bindgen generates Go bindings for compiled contracts. It reads solc
+// output (Name.abi with an optional Name.bin), Hardhat artifacts and Foundry
+// artifacts from a directory and writes each contract to its own package,
+// named after the lower-cased contract name, with its bytecode embedded so
+// the Deploy function works.
+//
+//	go run ./cmd/bindgen -in contracts/build -out contracts/bindings
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+type artifact struct {
+	name string
+	abi  string
+	bin  string
+	path string
+}
+
+// jsonArtifact covers Hardhat artifacts, whose bytecode is a string, and
+// Foundry artifacts, whose bytecode is an object.
+type jsonArtifact struct {
+	ContractName string          `json:"contractName"`
+	Abi          json.RawMessage `json:"abi"`
+	Bytecode     json.RawMessage `json:"bytecode"`
+}
+
+func main() {
+	in := flag.String("in", "contracts/build", "directory of solc, Hardhat or Foundry output")
+	out := flag.String("out", "contracts/bindings", "directory receiving a package per contract")
+	only := flag.String("contracts", "", "comma separated contracts to generate, all by default")
+	flag.Parse()
+
+	artifacts, err := loadArtifacts(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	artifacts, err = selectArtifacts(artifacts, *only)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, a := range artifacts {
+		path, err := generate(a, *out)
+		if err != nil {
+			log.Fatalf("%s: %v", a.path, err)
+		}
+		if a.bin == "" {
+			log.Printf("%s: no bytecode, Deploy%s not generated", path, a.name)
+		}
+	}
+}
+
+// loadArtifacts walks dir and returns its contracts sorted by name.
+func loadArtifacts(dir string) ([]artifact, error) {
+	artifacts := make([]artifact, 0)
+	seen := make(map[string]string)
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		var a *artifact
+		switch {
+		case strings.HasSuffix(path, ".abi"):
+			a, err = loadSolcArtifact(path)
+		case strings.HasSuffix(path, ".json") && !strings.HasSuffix(path, ".dbg.json"):
+			a, err = loadJsonArtifact(path)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if a == nil {
+			return nil
+		}
+
+		if previous, ok := seen[a.name]; ok {
+			return fmt.Errorf("contract %s found in %s and %s", a.name, previous, path)
+		}
+		seen[a.name] = path
+
+		artifacts = append(artifacts, *a)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(artifacts, func(i, j int) bool {
+		return artifacts[i].name < artifacts[j].name
+	})
+	return artifacts, nil
+}
+
+func loadSolcArtifact(path string) (*artifact, error) {
+	abiJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	binPath := strings.TrimSuffix(path, ".abi") + ".bin"
+	bin, err := os.ReadFile(binPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	return &artifact{
+		name: strings.TrimSuffix(filepath.Base(path), ".abi"),
+		abi:  string(abiJSON),
+		bin:  normalizeBytecode(string(bin)),
+		path: path,
+	}, nil
+}
+
+// loadJsonArtifact returns nil for JSON files that are not contract
+// artifacts, like Hardhat build info.
+func loadJsonArtifact(path string) (*artifact, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed jsonArtifact
+	if err := json.Unmarshal(content, &parsed); err != nil || len(parsed.Abi) == 0 {
+		return nil, nil
+	}
+
+	name := parsed.ContractName
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(path), ".json")
+	}
+
+	var bin string
+	if err := json.Unmarshal(parsed.Bytecode, &bin); err != nil {
+		var object struct {
+			Object string `json:"object"`
+		}
+		if err := json.Unmarshal(parsed.Bytecode, &object); err == nil {
+			bin = object.Object
+		}
+	}
+
+	return &artifact{
+		name: name,
+		abi:  string(parsed.Abi),
+		bin:  normalizeBytecode(bin),
+		path: path,
+	}, nil
+}
+
+func normalizeBytecode(bin string) string {
+	return strings.TrimPrefix(strings.TrimSpace(bin), "0x")
+}
+
+func selectArtifacts(artifacts []artifact, only string) ([]artifact, error) {
+	if only == "" {
+		return artifacts, nil
+	}
+
+	byName := make(map[string]artifact, len(artifacts))
+	for _, a := range artifacts {
+		byName[a.name] = a
+	}
+
+	selected := make([]artifact, 0)
+	for _, name := range strings.Split(only, ",") {
+		a, ok := byName[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("contract %s not found", name)
+		}
+		selected = append(selected, a)
+	}
+	return selected, nil
+}
+
+// generate writes the binding of a to out/<package>/<package>.go and returns
+// its path.
+func generate(a artifact, out string) (string, error) {
+	pkg := packageName(a.name)
+
+	code, err := bind.Bind([]string{a.name}, []string{a.abi}, []string{a.bin}, nil, pkg, bind.LangGo, nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(out, pkg)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, pkg+".go")
+	return path, os.WriteFile(path, []byte(code), 0644)
+}
+
+// packageName lower-cases name and drops the characters Go package names
+// cannot hold.
+func packageName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		default:
+			return -1
+		}
+	}, name)
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/nikola43/web3golanghelper/web3helper"
+)
+
+func balanceCommand(args []string) error {
+	flags, opts := newFlagSet("balance")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errUsage
+	}
+
+	address := flags.Arg(0)
+	if err := parseAddress(address); err != nil {
+		return err
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	balance, err := helper.Backend().BalanceAt(ctx, common.HexToAddress(address), nil)
+	if err != nil {
+		return err
+	}
+
+	return opts.print(record{
+		{"network", network.Name},
+		{"address", address},
+		{"balance", formatAmount(balance, network.NativeCurrency.Decimals)},
+		{"symbol", network.NativeCurrency.Symbol},
+		{"wei", balance.String()},
+	})
+}
+
+func sendCommand(args []string) error {
+	flags, opts := newFlagSet("send")
+	from := flags.String("from", "", "sender address from the wallet store")
+	to := flags.String("to", "", "recipient address")
+	value := flags.String("value", "", "amount in native units, e.g. 0.1")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := parseAddress(*to); err != nil {
+		return err
+	}
+
+	pk, err := opts.signer(*from)
+	if err != nil {
+		return err
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	if _, err := parseAmount(*value, network.NativeCurrency.Decimals); err != nil {
+		return err
+	}
+
+	txHash, nonce, err := helper.SendEth(common.HexToAddress(*from), *to, *value, pk)
+	if err != nil {
+		return err
+	}
+
+	return opts.print(record{
+		{"network", network.Name},
+		{"from", *from},
+		{"to", *to},
+		{"value", *value},
+		{"nonce", nonce.Uint64()},
+		{"txHash", txHash},
+	})
+}
+
+func walletNewCommand(args []string) error {
+	flags, opts := newFlagSet("wallet new")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	account, err := opts.wallets().Create()
+	if err != nil {
+		return err
+	}
+
+	return opts.print(record{{"address", account.PublicKey}})
+}
+
+// walletImportCommand reads the private key from stdin when it is not given
+// as argument, so it does not end up in the shell history.
+func walletImportCommand(args []string) error {
+	flags, opts := newFlagSet("wallet import")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	pk := flags.Arg(0)
+	if pk == "" {
+		fmt.Fprint(os.Stderr, "private key: ")
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			return err
+		}
+		pk = strings.TrimSpace(line)
+	}
+
+	account, err := opts.wallets().Import(pk)
+	if err != nil {
+		return err
+	}
+
+	return opts.print(record{{"address", account.PublicKey}})
+}
+
+func walletListCommand(args []string) error {
+	flags, opts := newFlagSet("wallet list")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	accounts, err := opts.wallets().List()
+	if err != nil {
+		return err
+	}
+
+	records := make([]web3helper.Result, 0, len(accounts))
+	for _, account := range accounts {
+		records = append(records, record{{"address", account.PublicKey}})
+	}
+	return opts.printList(records)
+}
+
+// tokenDecimals returns the decimals of token, 18 for the wrapped native token
+// without an rpc call.
+func tokenDecimals(ctx context.Context, helper *web3helper.Web3GolangHelper, network *web3helper.EVMNetwork, token string) (uint8, error) {
+	if strings.EqualFold(token, network.WrappedNative.Hex()) {
+		return network.NativeCurrency.Decimals, nil
+	}
+
+	info, err := helper.TokenInfo(ctx, token)
+	if err != nil {
+		return 0, err
+	}
+	return info.Decimals, nil
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/nikola43/web3golanghelper/web3helper"
+)
+
+// contractArgs returns the contract, method and arguments following the
+// flags of a contract command.
+func contractArgs(flags *flag.FlagSet) (common.Address, string, []interface{}, error) {
+	if flags.NArg() < 2 {
+		return common.Address{}, "", nil, errUsage
+	}
+
+	if err := parseAddress(flags.Arg(0)); err != nil {
+		return common.Address{}, "", nil, err
+	}
+
+	args := make([]interface{}, 0, flags.NArg()-2)
+	for _, arg := range flags.Args()[2:] {
+		args = append(args, arg)
+	}
+	return common.HexToAddress(flags.Arg(0)), flags.Arg(1), args, nil
+}
+
+func bindContract(helper *web3helper.Web3GolangHelper, address common.Address, abiFile string) (*web3helper.Contract, error) {
+	if abiFile == "" {
+		return nil, errors.New("--abi is required")
+	}
+	return helper.BindContractFile(address, abiFile)
+}
+
+func contractCallCommand(args []string) error {
+	flags, opts := newFlagSet("contract call")
+	abiFile := flags.String("abi", "", "ABI or artifact JSON file")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	address, method, methodArgs, err := contractArgs(flags)
+	if err != nil {
+		return err
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	contract, err := bindContract(helper, address, *abiFile)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	outputs, err := contract.Call(ctx, method, methodArgs...)
+	if err != nil {
+		return err
+	}
+
+	result := record{
+		{"network", network.Name},
+		{"contract", address.Hex()},
+		{"method", method},
+	}
+	for i, output := range contract.ABI.Methods[method].Outputs {
+		name := output.Name
+		if name == "" {
+			name = fmt.Sprintf("output%d", i)
+		}
+		result = append(result, field{name, outputs[i]})
+	}
+
+	return opts.print(result)
+}
+
+func contractSendCommand(args []string) error {
+	flags, opts := newFlagSet("contract send")
+	from := flags.String("from", "", "sender address from the wallet store")
+	abiFile := flags.String("abi", "", "ABI or artifact JSON file")
+	value := flags.String("value", "0", "native currency sent along, e.g. 0.1")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	address, method, methodArgs, err := contractArgs(flags)
+	if err != nil {
+		return err
+	}
+
+	amount, err := parseAmount(*value, 18)
+	if err != nil {
+		return err
+	}
+
+	pk, err := opts.signer(*from)
+	if err != nil {
+		return err
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	contract, err := bindContract(helper, address, *abiFile)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	tx, err := contract.TransactWithValue(ctx, pk, amount, method, methodArgs...)
+	if err != nil {
+		return err
+	}
+
+	return opts.print(record{
+		{"network", network.Name},
+		{"contract", address.Hex()},
+		{"method", method},
+		{"from", *from},
+		{"value", *value},
+		{"nonce", tx.Nonce()},
+		{"gasLimit", tx.Gas()},
+		{"txHash", tx.Hash().Hex()},
+	})
+}
+
+func contractEstimateCommand(args []string) error {
+	flags, opts := newFlagSet("contract estimate")
+	from := flags.String("from", "", "sender address")
+	abiFile := flags.String("abi", "", "ABI or artifact JSON file")
+	value := flags.String("value", "0", "native currency sent along, e.g. 0.1")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	address, method, methodArgs, err := contractArgs(flags)
+	if err != nil {
+		return err
+	}
+
+	var sender common.Address
+	if *from != "" {
+		if err := parseAddress(*from); err != nil {
+			return err
+		}
+		sender = common.HexToAddress(*from)
+	}
+
+	amount, err := parseAmount(*value, 18)
+	if err != nil {
+		return err
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	contract, err := bindContract(helper, address, *abiFile)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	data, err := contract.Pack(method, methodArgs...)
+	if err != nil {
+		return err
+	}
+
+	quote, err := helper.QuoteGas(ctx, ethereum.CallMsg{
+		From:  sender,
+		To:    &address,
+		Value: amount,
+		Data:  data,
+	})
+	if err != nil {
+		return err
+	}
+
+	return opts.print(record{
+		{"network", network.Name},
+		{"contract", address.Hex()},
+		{"method", method},
+		{"estimated", quote.Estimated},
+		{"gasLimit", quote.GasLimit},
+		{"gasPrice", quote.GasPrice.String()},
+		{"maxFee", quote.MaxFeeNative.String()},
+	})
+}
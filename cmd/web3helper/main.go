@@ -0,0 +1,324 @@
+// Command web3helper exposes the web3helper package to the shell.
+//
+//	web3helper <command> [subcommand] [flags] [args]
+//
+// Every command accepts --network, --networks-file, --wallets, --output and --json.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/nikola43/web3golanghelper/web3helper"
+)
+
+const usage = `usage: web3helper <command> [flags] [args]
+
+commands:
+  balance <address>                        native balance of an address
+  send --from A --to B --value V           send native currency
+  token info <token>                       name, symbol, decimals and supply
+  token balance <token> <owner>            token balance of an address
+  token transfer --from A --to B --amount V <token>
+  token approve --from A --spender S --amount V|max <token>
+  swap quote --amount V --path T1,T2[,..]  router amounts out
+  swap buy --from A --token T --amount V   swap native currency for a token
+  swap sell --from A --token T --amount V  swap a token for native currency
+  contract call --abi F <contract> <method> [args...]
+  contract send --from A --abi F [--value V] <contract> <method> [args...]
+  contract estimate [--from A] --abi F [--value V] <contract> <method> [args...]
+  pair reserves <pair> | --token-a A --token-b B
+  wallet new | import [pk] | list
+  tx status <hash>
+  tx cancel --from A <hash>
+  tx speedup --from A <hash>
+  tx build --from A --to B [--value V] [--data D] --out F [--qr F]
+  tx sign --from A <unsigned-file>         sign offline, prints the raw tx
+  tx broadcast --unsigned F <raw>          check and send a raw tx
+  watch events <contract> [contract...]
+
+Flags may come before or after the arguments, arguments after -- are never
+read as flags.
+
+global flags:
+  --network NAME        network from the registry (default $WEB3HELPER_NETWORK or bsc-testnet)
+  --networks-file PATH  extra networks in JSON or YAML
+  --wallets DIR         wallet store directory (default ./wallets)
+  --output FORMAT       text, json, ndjson or csv (default text)
+  --json                shorthand for --output json
+  --log-level LEVEL     log to stderr at debug, info, warn or error
+  --metrics-addr ADDR   serve Prometheus metrics on ADDR/metrics, e.g. :9100
+`
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] == "help" || os.Args[1] == "-h" || os.Args[1] == "--help" {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err := run(os.Args[1], os.Args[2:]); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+type commandFunc func(args []string) error
+
+var errUsage = errors.New("invalid usage, run web3helper help")
+
+func run(name string, args []string) error {
+	switch name {
+	case "balance":
+		return balanceCommand(args)
+	case "send":
+		return sendCommand(args)
+	case "token":
+		return runSubcommand(args, map[string]commandFunc{
+			"info":     tokenInfoCommand,
+			"balance":  tokenBalanceCommand,
+			"transfer": tokenTransferCommand,
+			"approve":  tokenApproveCommand,
+		})
+	case "swap":
+		return runSubcommand(args, map[string]commandFunc{
+			"quote": swapQuoteCommand,
+			"buy":   swapBuyCommand,
+			"sell":  swapSellCommand,
+		})
+	case "contract":
+		return runSubcommand(args, map[string]commandFunc{
+			"call":     contractCallCommand,
+			"send":     contractSendCommand,
+			"estimate": contractEstimateCommand,
+		})
+	case "pair":
+		return runSubcommand(args, map[string]commandFunc{
+			"reserves": pairReservesCommand,
+		})
+	case "wallet":
+		return runSubcommand(args, map[string]commandFunc{
+			"new":    walletNewCommand,
+			"import": walletImportCommand,
+			"list":   walletListCommand,
+		})
+	case "tx":
+		return runSubcommand(args, map[string]commandFunc{
+			"status":    txStatusCommand,
+			"cancel":    txCancelCommand,
+			"speedup":   txSpeedUpCommand,
+			"build":     txBuildCommand,
+			"sign":      txSignCommand,
+			"broadcast": txBroadcastCommand,
+		})
+	case "watch":
+		return runSubcommand(args, map[string]commandFunc{
+			"events": watchEventsCommand,
+		})
+	}
+
+	return fmt.Errorf("unknown command %q, run web3helper help", name)
+}
+
+func runSubcommand(args []string, subcommands map[string]commandFunc) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	subcommand, ok := subcommands[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown subcommand %q, run web3helper help", args[0])
+	}
+	return subcommand(args[1:])
+}
+
+// options are the flags shared by every command.
+type options struct {
+	network      string
+	networksFile string
+	walletsDir   string
+	json         bool
+	output       string
+	logLevel     string
+	metricsAddr  string
+	timeout      time.Duration
+
+	outputRenderer web3helper.Renderer
+}
+
+func newFlagSet(name string) (*flag.FlagSet, *options) {
+	opts := &options{}
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+
+	defaultNetwork := os.Getenv("WEB3HELPER_NETWORK")
+	if defaultNetwork == "" {
+		defaultNetwork = "bsc-testnet"
+	}
+
+	flags.StringVar(&opts.network, "network", defaultNetwork, "network name")
+	flags.StringVar(&opts.networksFile, "networks-file", "", "extra networks file")
+	flags.StringVar(&opts.walletsDir, "wallets", "./wallets", "wallet store directory")
+	flags.BoolVar(&opts.json, "json", false, "shorthand for --output json")
+	flags.StringVar(&opts.output, "output", web3helper.TextFormat, "output format: text, json, ndjson or csv")
+	flags.StringVar(&opts.logLevel, "log-level", "", "log to stderr at debug, info, warn or error")
+	flags.StringVar(&opts.metricsAddr, "metrics-addr", "", "serve Prometheus metrics on this address")
+	flags.DurationVar(&opts.timeout, "timeout", time.Minute, "rpc timeout")
+	return flags, opts
+}
+
+// parseFlags parses args with flags allowed after the positional arguments,
+// as in "balance 0x.. --json", which the flag package stops at. Arguments
+// after "--" stay positional.
+func parseFlags(flags *flag.FlagSet, args []string) error {
+	var flagArgs, positional []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			positional = append(positional, args[i+1:]...)
+			break
+		}
+
+		name := strings.TrimLeft(arg, "-")
+		if !strings.HasPrefix(arg, "-") || name == "" || isNumber(arg) {
+			positional = append(positional, arg)
+			continue
+		}
+
+		flagArgs = append(flagArgs, arg)
+		if strings.Contains(name, "=") {
+			continue
+		}
+
+		// the value of a non boolean flag is the next argument
+		if f := flags.Lookup(name); f != nil && !isBoolFlag(f) && i+1 < len(args) {
+			i++
+			flagArgs = append(flagArgs, args[i])
+		}
+	}
+
+	return flags.Parse(append(append(flagArgs, "--"), positional...))
+}
+
+func isBoolFlag(f *flag.Flag) bool {
+	boolFlag, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && boolFlag.IsBoolFlag()
+}
+
+func isNumber(arg string) bool {
+	_, err := decimal.NewFromString(arg)
+	return err == nil
+}
+
+func (o *options) resolveNetwork() (*web3helper.EVMNetwork, error) {
+	registry := web3helper.DefaultNetworkRegistry()
+	if o.networksFile != "" {
+		if err := registry.LoadFile(o.networksFile); err != nil {
+			return nil, err
+		}
+	}
+	return registry.ByName(o.network)
+}
+
+func (o *options) connect() (*web3helper.Web3GolangHelper, *web3helper.EVMNetwork, error) {
+	network, err := o.resolveNetwork()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	level := web3helper.InfoLogLevel
+	if o.logLevel != "" {
+		if level, err = web3helper.ParseLogLevel(o.logLevel); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	helper, err := web3helper.DialNetwork(*network)
+	if err != nil {
+		return nil, nil, err
+	}
+	if o.logLevel != "" {
+		helper.SetLogger(web3helper.NewTextLogger(os.Stderr))
+		helper.SetLogLevel(level)
+	}
+
+	if o.metricsAddr != "" {
+		sink := web3helper.NewPrometheusSink()
+		helper.SetMetricsSink(sink)
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", sink)
+		go func() {
+			if err := http.ListenAndServe(o.metricsAddr, mux); err != nil {
+				fmt.Fprintln(os.Stderr, "metrics:", err)
+			}
+		}()
+	}
+	return helper, network, nil
+}
+
+func (o *options) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), o.timeout)
+}
+
+func (o *options) wallets() *web3helper.WalletStore {
+	return web3helper.NewWalletStore(o.walletsDir)
+}
+
+// signer returns the private key of from, taken from the wallet store.
+func (o *options) signer(from string) (string, error) {
+	if from == "" {
+		return "", errors.New("--from is required")
+	}
+
+	account, err := o.wallets().Get(from)
+	if err != nil {
+		return "", err
+	}
+	return account.PrivateKey, nil
+}
+
+// parseAmount converts a decimal amount such as "1.5" to base units.
+func parseAmount(amount string, decimals uint8) (*big.Int, error) {
+	if amount == "max" {
+		return new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)), nil
+	}
+
+	value, err := decimal.NewFromString(amount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount %q", amount)
+	}
+	if value.IsNegative() {
+		return nil, fmt.Errorf("invalid amount %q", amount)
+	}
+
+	return value.Shift(int32(decimals)).BigInt(), nil
+}
+
+func formatAmount(amount *big.Int, decimals uint8) string {
+	return web3helper.ToDecimal(amount, int(decimals)).String()
+}
+
+func parseAddress(address string) error {
+	if !web3helper.ValidateAddress(address) {
+		return fmt.Errorf("invalid address %q", address)
+	}
+	return nil
+}
+
+func splitAddresses(list string) ([]string, error) {
+	addresses := strings.Split(list, ",")
+	for _, address := range addresses {
+		if err := parseAddress(address); err != nil {
+			return nil, err
+		}
+	}
+	return addresses, nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"os"
+
+	"github.com/nikola43/web3golanghelper/web3helper"
+)
+
+// record is the result of a command. It is declared locally so commands can
+// use unkeyed field literals.
+type record []field
+
+type field struct {
+	name  string
+	value interface{}
+}
+
+func (r record) Fields() web3helper.Fields {
+	fields := make(web3helper.Fields, 0, len(r))
+	for _, f := range r {
+		fields = append(fields, web3helper.Field{Name: f.name, Value: f.value})
+	}
+	return fields
+}
+
+// renderer returns the renderer selected by --output, or JSON with --json. It
+// is created once so streaming commands write a single CSV header.
+func (o *options) renderer() (web3helper.Renderer, error) {
+	if o.outputRenderer != nil {
+		return o.outputRenderer, nil
+	}
+
+	format := o.output
+	if o.json {
+		format = web3helper.JSONFormat
+	}
+
+	renderer, err := web3helper.NewRenderer(format, true)
+	if err != nil {
+		return nil, err
+	}
+
+	o.outputRenderer = renderer
+	return renderer, nil
+}
+
+func (o *options) print(result web3helper.Result) error {
+	renderer, err := o.renderer()
+	if err != nil {
+		return err
+	}
+	return renderer.Render(os.Stdout, result)
+}
+
+func (o *options) printList(results []web3helper.Result) error {
+	renderer, err := o.renderer()
+	if err != nil {
+		return err
+	}
+	return renderer.RenderList(os.Stdout, results)
+}
@@ -0,0 +1,287 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/nikola43/web3golanghelper/web3helper"
+)
+
+func swapQuoteCommand(args []string) error {
+	flags, opts := newFlagSet("swap quote")
+	dexName := flags.String("dex", "", "dex name from the network config")
+	amount := flags.String("amount", "", "amount of the first path token, e.g. 0.1")
+	pathList := flags.String("path", "", "comma separated token addresses")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	path, err := splitAddresses(*pathList)
+	if err != nil {
+		return err
+	}
+	if len(path) < 2 {
+		return errors.New("--path needs at least two tokens")
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	dex, err := helper.Dex(*dexName)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	decimalsIn, err := tokenDecimals(ctx, helper, network, path[0])
+	if err != nil {
+		return err
+	}
+	decimalsOut, err := tokenDecimals(ctx, helper, network, path[len(path)-1])
+	if err != nil {
+		return err
+	}
+
+	amountIn, err := parseAmount(*amount, decimalsIn)
+	if err != nil {
+		return err
+	}
+
+	amounts, err := helper.QuoteSwap(ctx, dex.Router, amountIn, toAddresses(path))
+	if err != nil {
+		return err
+	}
+	amountOut := amounts[len(amounts)-1]
+
+	return opts.print(record{
+		{"network", network.Name},
+		{"dex", dex.Name},
+		{"path", path},
+		{"amountIn", *amount},
+		{"amountOut", formatAmount(amountOut, decimalsOut)},
+		{"amountOutRaw", amountOut.String()},
+	})
+}
+
+func swapBuyCommand(args []string) error {
+	flags, opts := newFlagSet("swap buy")
+	from := flags.String("from", "", "buyer address from the wallet store")
+	token := flags.String("token", "", "token to buy")
+	amount := flags.String("amount", "", "native amount to spend, e.g. 0.1")
+	dexName := flags.String("dex", "", "dex name from the network config")
+	slippage := flags.Int64("slippage", 50, "accepted slippage in basis points")
+	deadline := flags.Duration("deadline", 10*time.Minute, "swap deadline from now")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := parseAddress(*token); err != nil {
+		return err
+	}
+	if *slippage < 0 || *slippage > 10000 {
+		return errors.New("--slippage must be between 0 and 10000 basis points")
+	}
+
+	pk, err := opts.signer(*from)
+	if err != nil {
+		return err
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	dex, err := helper.Dex(*dexName)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	amountIn, err := parseAmount(*amount, network.NativeCurrency.Decimals)
+	if err != nil {
+		return err
+	}
+
+	path := []common.Address{network.WrappedNative, common.HexToAddress(*token)}
+	amounts, err := helper.QuoteSwap(ctx, dex.Router, amountIn, path)
+	if err != nil {
+		return err
+	}
+	amountOutMin := web3helper.ApplySlippage(amounts[len(amounts)-1], *slippage)
+
+	tx, err := helper.SwapExactETHForTokens(ctx, dex.Router, amountIn, amountOutMin, path, common.HexToAddress(*from), swapDeadline(*deadline), pk)
+	if err != nil {
+		return err
+	}
+
+	return opts.print(record{
+		{"network", network.Name},
+		{"dex", dex.Name},
+		{"token", *token},
+		{"amountIn", *amount},
+		{"amountOutMin", amountOutMin.String()},
+		{"nonce", tx.Nonce()},
+		{"txHash", tx.Hash().Hex()},
+	})
+}
+
+func swapSellCommand(args []string) error {
+	flags, opts := newFlagSet("swap sell")
+	from := flags.String("from", "", "seller address from the wallet store")
+	token := flags.String("token", "", "token to sell")
+	amount := flags.String("amount", "", "token amount to sell, e.g. 100")
+	dexName := flags.String("dex", "", "dex name from the network config")
+	slippage := flags.Int64("slippage", 50, "accepted slippage in basis points")
+	deadline := flags.Duration("deadline", 10*time.Minute, "swap deadline from now")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := parseAddress(*token); err != nil {
+		return err
+	}
+	if *slippage < 0 || *slippage > 10000 {
+		return errors.New("--slippage must be between 0 and 10000 basis points")
+	}
+
+	pk, err := opts.signer(*from)
+	if err != nil {
+		return err
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	dex, err := helper.Dex(*dexName)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	decimals, err := tokenDecimals(ctx, helper, network, *token)
+	if err != nil {
+		return err
+	}
+
+	amountIn, err := parseAmount(*amount, decimals)
+	if err != nil {
+		return err
+	}
+
+	allowance, err := helper.TokenAllowance(ctx, *token, *from, dex.Router.Hex())
+	if err != nil {
+		return err
+	}
+	if allowance.Cmp(amountIn) < 0 {
+		return fmt.Errorf("router allowance is %s, run: web3helper token approve --from %s --spender %s --amount max %s",
+			formatAmount(allowance, decimals), *from, dex.Router.Hex(), *token)
+	}
+
+	path := []common.Address{common.HexToAddress(*token), network.WrappedNative}
+	amounts, err := helper.QuoteSwap(ctx, dex.Router, amountIn, path)
+	if err != nil {
+		return err
+	}
+	amountOutMin := web3helper.ApplySlippage(amounts[len(amounts)-1], *slippage)
+
+	tx, err := helper.SwapExactTokensForETH(ctx, dex.Router, amountIn, amountOutMin, path, common.HexToAddress(*from), swapDeadline(*deadline), pk)
+	if err != nil {
+		return err
+	}
+
+	return opts.print(record{
+		{"network", network.Name},
+		{"dex", dex.Name},
+		{"token", *token},
+		{"amountIn", *amount},
+		{"amountOutMin", formatAmount(amountOutMin, network.NativeCurrency.Decimals)},
+		{"nonce", tx.Nonce()},
+		{"txHash", tx.Hash().Hex()},
+	})
+}
+
+func pairReservesCommand(args []string) error {
+	flags, opts := newFlagSet("pair reserves")
+	dexName := flags.String("dex", "", "dex name from the network config")
+	tokenA := flags.String("token-a", "", "first token, used with --token-b instead of a pair address")
+	tokenB := flags.String("token-b", "", "second token")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	var pair common.Address
+	switch {
+	case flags.NArg() == 1:
+		if err := parseAddress(flags.Arg(0)); err != nil {
+			return err
+		}
+		pair = common.HexToAddress(flags.Arg(0))
+	case *tokenA != "" && *tokenB != "":
+		if _, err := splitAddresses(*tokenA + "," + *tokenB); err != nil {
+			return err
+		}
+
+		dex, err := helper.Dex(*dexName)
+		if err != nil {
+			return err
+		}
+
+		pair, err = helper.GetPairAddress(ctx, dex.Factory, common.HexToAddress(*tokenA), common.HexToAddress(*tokenB))
+		if err != nil {
+			return err
+		}
+		if pair == (common.Address{}) {
+			return errors.New("the factory has no pair for these tokens")
+		}
+	default:
+		return errUsage
+	}
+
+	reserves, err := helper.GetPairReserves(ctx, pair)
+	if err != nil {
+		return err
+	}
+
+	return opts.print(record{
+		{"network", network.Name},
+		{"pair", reserves.Pair.Hex()},
+		{"token0", reserves.Token0.Hex()},
+		{"token1", reserves.Token1.Hex()},
+		{"reserve0", reserves.Reserve0.String()},
+		{"reserve1", reserves.Reserve1.String()},
+		{"blockTimestampLast", reserves.BlockTimestampLast},
+	})
+}
+
+func toAddresses(addresses []string) []common.Address {
+	result := make([]common.Address, 0, len(addresses))
+	for _, address := range addresses {
+		result = append(result, common.HexToAddress(address))
+	}
+	return result
+}
+
+func swapDeadline(after time.Duration) *big.Int {
+	return big.NewInt(time.Now().Add(after).Unix())
+}
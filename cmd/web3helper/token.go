@@ -0,0 +1,201 @@
+package main
+
+func tokenInfoCommand(args []string) error {
+	flags, opts := newFlagSet("token info")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errUsage
+	}
+
+	token := flags.Arg(0)
+	if err := parseAddress(token); err != nil {
+		return err
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	info, err := helper.TokenInfo(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	return opts.print(record{
+		{"network", network.Name},
+		{"address", info.Address.Hex()},
+		{"name", info.Name},
+		{"symbol", info.Symbol},
+		{"decimals", info.Decimals},
+		{"totalSupply", formatAmount(info.TotalSupply, info.Decimals)},
+	})
+}
+
+func tokenBalanceCommand(args []string) error {
+	flags, opts := newFlagSet("token balance")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		return errUsage
+	}
+
+	token, owner := flags.Arg(0), flags.Arg(1)
+	if err := parseAddress(token); err != nil {
+		return err
+	}
+	if err := parseAddress(owner); err != nil {
+		return err
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	info, err := helper.TokenInfo(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	balance, err := helper.TokenBalance(ctx, token, owner)
+	if err != nil {
+		return err
+	}
+
+	return opts.print(record{
+		{"network", network.Name},
+		{"token", token},
+		{"owner", owner},
+		{"balance", formatAmount(balance, info.Decimals)},
+		{"symbol", info.Symbol},
+		{"raw", balance.String()},
+	})
+}
+
+func tokenTransferCommand(args []string) error {
+	flags, opts := newFlagSet("token transfer")
+	from := flags.String("from", "", "sender address from the wallet store")
+	to := flags.String("to", "", "recipient address")
+	amount := flags.String("amount", "", "amount in token units, e.g. 1.5")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errUsage
+	}
+
+	token := flags.Arg(0)
+	if err := parseAddress(token); err != nil {
+		return err
+	}
+	if err := parseAddress(*to); err != nil {
+		return err
+	}
+
+	pk, err := opts.signer(*from)
+	if err != nil {
+		return err
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	decimals, err := tokenDecimals(ctx, helper, network, token)
+	if err != nil {
+		return err
+	}
+
+	value, err := parseAmount(*amount, decimals)
+	if err != nil {
+		return err
+	}
+
+	tx, err := helper.TransferToken(ctx, token, *to, value, pk)
+	if err != nil {
+		return err
+	}
+
+	return opts.print(record{
+		{"network", network.Name},
+		{"token", token},
+		{"from", *from},
+		{"to", *to},
+		{"amount", *amount},
+		{"nonce", tx.Nonce()},
+		{"txHash", tx.Hash().Hex()},
+	})
+}
+
+func tokenApproveCommand(args []string) error {
+	flags, opts := newFlagSet("token approve")
+	from := flags.String("from", "", "owner address from the wallet store")
+	spender := flags.String("spender", "", "spender address")
+	amount := flags.String("amount", "", "amount in token units, or max")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errUsage
+	}
+
+	token := flags.Arg(0)
+	if err := parseAddress(token); err != nil {
+		return err
+	}
+	if err := parseAddress(*spender); err != nil {
+		return err
+	}
+
+	pk, err := opts.signer(*from)
+	if err != nil {
+		return err
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	decimals, err := tokenDecimals(ctx, helper, network, token)
+	if err != nil {
+		return err
+	}
+
+	value, err := parseAmount(*amount, decimals)
+	if err != nil {
+		return err
+	}
+
+	tx, err := helper.ApproveToken(ctx, token, *spender, value, pk)
+	if err != nil {
+		return err
+	}
+
+	return opts.print(record{
+		{"network", network.Name},
+		{"token", token},
+		{"owner", *from},
+		{"spender", *spender},
+		{"amount", *amount},
+		{"nonce", tx.Nonce()},
+		{"txHash", tx.Hash().Hex()},
+	})
+}
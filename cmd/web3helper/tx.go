@@ -0,0 +1,304 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/nikola43/web3golanghelper/web3helper"
+)
+
+func txStatusCommand(args []string) error {
+	flags, opts := newFlagSet("tx status")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errUsage
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	status, err := helper.TransactionStatus(ctx, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	state := "not found"
+	switch {
+	case status.Pending:
+		state = "pending"
+	case status.Succeeded():
+		state = "success"
+	case status.Found:
+		state = "reverted"
+	}
+
+	result := record{
+		{"network", network.Name},
+		{"txHash", status.Hash.Hex()},
+		{"status", state},
+	}
+
+	if status.Tx != nil {
+		result = append(result,
+			field{"nonce", status.Tx.Nonce()},
+			field{"gasLimit", status.Tx.Gas()},
+		)
+	}
+	if status.Receipt != nil {
+		result = append(result,
+			field{"blockNumber", status.Receipt.BlockNumber.Uint64()},
+			field{"gasUsed", status.Receipt.GasUsed},
+		)
+	}
+	if status.Revert != nil {
+		result = append(result, field{"revertReason", status.Revert.Reason})
+	}
+	if explorer, err := helper.Explorer(); err == nil {
+		result = append(result, field{"explorer", explorer.TxUrl(status.Hash.Hex())})
+	}
+
+	return opts.print(result)
+}
+
+func txCancelCommand(args []string) error {
+	return replaceTxCommand("tx cancel", args, true)
+}
+
+func txSpeedUpCommand(args []string) error {
+	return replaceTxCommand("tx speedup", args, false)
+}
+
+func replaceTxCommand(name string, args []string, cancelTx bool) error {
+	flags, opts := newFlagSet(name)
+	from := flags.String("from", "", "sender of the pending transaction from the wallet store")
+	bump := flags.Int64("bump", 20, "fee increase in percent, at least 10")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errUsage
+	}
+
+	pk, err := opts.signer(*from)
+	if err != nil {
+		return err
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	replace := helper.SpeedUpTransaction
+	if cancelTx {
+		replace = helper.CancelPendingTransaction
+	}
+
+	if *bump < web3helper.MinReplacementBumpPercent {
+		*bump = web3helper.MinReplacementBumpPercent
+	}
+
+	tx, err := replace(ctx, flags.Arg(0), *bump, pk)
+	if err != nil {
+		return err
+	}
+
+	return opts.print(record{
+		{"network", network.Name},
+		{"replaced", flags.Arg(0)},
+		{"nonce", tx.Nonce()},
+		{"txHash", tx.Hash().Hex()},
+	})
+}
+
+func txBuildCommand(args []string) error {
+	flags, opts := newFlagSet("tx build")
+	from := flags.String("from", "", "address that signs the transaction offline")
+	to := flags.String("to", "", "recipient or contract address")
+	value := flags.String("value", "0", "native currency sent along, e.g. 0.1")
+	data := flags.String("data", "", "calldata in hex")
+	out := flags.String("out", "", "file the unsigned transaction is written to as JSON")
+	qr := flags.String("qr", "", "file a PNG QR code of the transaction is written to")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if *out == "" {
+		return errors.New("--out is required")
+	}
+	if err := parseAddress(*from); err != nil {
+		return err
+	}
+	if err := parseAddress(*to); err != nil {
+		return err
+	}
+
+	var calldata []byte
+	if *data != "" {
+		decoded, err := hexutil.Decode(ensureHexPrefix(*data))
+		if err != nil {
+			return fmt.Errorf("invalid --data: %w", err)
+		}
+		calldata = decoded
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	amount, err := parseAmount(*value, network.NativeCurrency.Decimals)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	recipient := common.HexToAddress(*to)
+	unsigned, err := helper.BuildUnsignedTransaction(ctx, ethereum.CallMsg{
+		From:  common.HexToAddress(*from),
+		To:    &recipient,
+		Value: amount,
+		Data:  calldata,
+	})
+	if err != nil {
+		return err
+	}
+
+	encoded, err := json.MarshalIndent(unsigned, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(*out, encoded, 0o644); err != nil {
+		return err
+	}
+
+	if *qr != "" {
+		png, err := unsigned.QRCode(512)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(*qr, png, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return opts.print(record{
+		{"network", network.Name},
+		{"from", *from},
+		{"to", *to},
+		{"nonce", unsigned.Tx.Nonce()},
+		{"gasLimit", unsigned.Tx.Gas()},
+		{"out", *out},
+	})
+}
+
+// txSignCommand needs no connection, it runs on the offline machine.
+func txSignCommand(args []string) error {
+	flags, opts := newFlagSet("tx sign")
+	from := flags.String("from", "", "signer address from the wallet store")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errUsage
+	}
+
+	unsigned, err := readUnsignedTransaction(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	pk, err := opts.signer(*from)
+	if err != nil {
+		return err
+	}
+
+	signed, err := web3helper.SignUnsignedTransaction(unsigned, pk)
+	if err != nil {
+		return err
+	}
+
+	raw, err := web3helper.EncodeSignedTransaction(signed)
+	if err != nil {
+		return err
+	}
+
+	return opts.print(record{
+		{"from", *from},
+		{"nonce", signed.Nonce()},
+		{"txHash", signed.Hash().Hex()},
+		{"raw", raw},
+	})
+}
+
+func txBroadcastCommand(args []string) error {
+	flags, opts := newFlagSet("tx broadcast")
+	unsignedFile := flags.String("unsigned", "", "unsigned transaction the raw one was signed from")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errUsage
+	}
+	if *unsignedFile == "" {
+		return errors.New("--unsigned is required")
+	}
+
+	unsigned, err := readUnsignedTransaction(*unsignedFile)
+	if err != nil {
+		return err
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	tx, err := helper.BroadcastSignedTransaction(ctx, unsigned, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	return opts.print(record{
+		{"network", network.Name},
+		{"from", unsigned.From.Hex()},
+		{"nonce", tx.Nonce()},
+		{"txHash", tx.Hash().Hex()},
+	})
+}
+
+func readUnsignedTransaction(path string) (*web3helper.UnsignedTransaction, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return web3helper.ParseUnsignedTransaction(content)
+}
+
+func ensureHexPrefix(data string) string {
+	if strings.HasPrefix(data, "0x") {
+		return data
+	}
+	return "0x" + data
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"os"
+	"os/signal"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// watchEventsCommand prints the logs of the given contracts until interrupted.
+// With --output ndjson or csv the logs can be streamed to another program.
+func watchEventsCommand(args []string) error {
+	flags, opts := newFlagSet("watch events")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		return errUsage
+	}
+
+	for _, contract := range flags.Args() {
+		if err := parseAddress(contract); err != nil {
+			return err
+		}
+	}
+
+	helper, network, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	logs := make(chan types.Log)
+	sub, err := helper.ListenBridgesEventsV2(flags.Args(), logs)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+
+	for {
+		select {
+		case <-interrupt:
+			return nil
+		case err := <-sub.Err():
+			return err
+		case vLog := <-logs:
+			topics := make([]string, 0, len(vLog.Topics))
+			for _, topic := range vLog.Topics {
+				topics = append(topics, topic.Hex())
+			}
+
+			if err := opts.print(record{
+				{"network", network.Name},
+				{"address", vLog.Address.Hex()},
+				{"blockNumber", vLog.BlockNumber},
+				{"txHash", vLog.TxHash.Hex()},
+				{"logIndex", vLog.Index},
+				{"removed", vLog.Removed},
+				{"topics", topics},
+				{"data", common.Bytes2Hex(vLog.Data)},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
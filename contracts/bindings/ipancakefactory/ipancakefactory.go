@@ -0,0 +1,553 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ipancakefactory
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// IPancakeFactoryMetaData contains all meta data concerning the IPancakeFactory contract.
+var IPancakeFactoryMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"token0\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"token1\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"pair\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"PairCreated\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"allPairs\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"pair\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"allPairsLength\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"tokenA\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenB\",\"type\":\"address\"}],\"name\":\"createPair\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"pair\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"feeTo\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"feeToSetter\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"tokenA\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenB\",\"type\":\"address\"}],\"name\":\"getPair\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"pair\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"setFeeTo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"setFeeToSetter\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// IPancakeFactoryABI is the input ABI used to generate the binding from.
+// Deprecated: Use IPancakeFactoryMetaData.ABI instead.
+var IPancakeFactoryABI = IPancakeFactoryMetaData.ABI
+
+// IPancakeFactory is an auto generated Go binding around an Ethereum contract.
+type IPancakeFactory struct {
+	IPancakeFactoryCaller     // Read-only binding to the contract
+	IPancakeFactoryTransactor // Write-only binding to the contract
+	IPancakeFactoryFilterer   // Log filterer for contract events
+}
+
+// IPancakeFactoryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IPancakeFactoryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IPancakeFactoryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IPancakeFactoryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IPancakeFactoryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IPancakeFactoryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IPancakeFactorySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IPancakeFactorySession struct {
+	Contract     *IPancakeFactory  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IPancakeFactoryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IPancakeFactoryCallerSession struct {
+	Contract *IPancakeFactoryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// IPancakeFactoryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IPancakeFactoryTransactorSession struct {
+	Contract     *IPancakeFactoryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// IPancakeFactoryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IPancakeFactoryRaw struct {
+	Contract *IPancakeFactory // Generic contract binding to access the raw methods on
+}
+
+// IPancakeFactoryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IPancakeFactoryCallerRaw struct {
+	Contract *IPancakeFactoryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IPancakeFactoryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IPancakeFactoryTransactorRaw struct {
+	Contract *IPancakeFactoryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIPancakeFactory creates a new instance of IPancakeFactory, bound to a specific deployed contract.
+func NewIPancakeFactory(address common.Address, backend bind.ContractBackend) (*IPancakeFactory, error) {
+	contract, err := bindIPancakeFactory(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IPancakeFactory{IPancakeFactoryCaller: IPancakeFactoryCaller{contract: contract}, IPancakeFactoryTransactor: IPancakeFactoryTransactor{contract: contract}, IPancakeFactoryFilterer: IPancakeFactoryFilterer{contract: contract}}, nil
+}
+
+// NewIPancakeFactoryCaller creates a new read-only instance of IPancakeFactory, bound to a specific deployed contract.
+func NewIPancakeFactoryCaller(address common.Address, caller bind.ContractCaller) (*IPancakeFactoryCaller, error) {
+	contract, err := bindIPancakeFactory(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IPancakeFactoryCaller{contract: contract}, nil
+}
+
+// NewIPancakeFactoryTransactor creates a new write-only instance of IPancakeFactory, bound to a specific deployed contract.
+func NewIPancakeFactoryTransactor(address common.Address, transactor bind.ContractTransactor) (*IPancakeFactoryTransactor, error) {
+	contract, err := bindIPancakeFactory(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IPancakeFactoryTransactor{contract: contract}, nil
+}
+
+// NewIPancakeFactoryFilterer creates a new log filterer instance of IPancakeFactory, bound to a specific deployed contract.
+func NewIPancakeFactoryFilterer(address common.Address, filterer bind.ContractFilterer) (*IPancakeFactoryFilterer, error) {
+	contract, err := bindIPancakeFactory(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IPancakeFactoryFilterer{contract: contract}, nil
+}
+
+// bindIPancakeFactory binds a generic wrapper to an already deployed contract.
+func bindIPancakeFactory(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(IPancakeFactoryABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IPancakeFactory *IPancakeFactoryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IPancakeFactory.Contract.IPancakeFactoryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IPancakeFactory *IPancakeFactoryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IPancakeFactory.Contract.IPancakeFactoryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IPancakeFactory *IPancakeFactoryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IPancakeFactory.Contract.IPancakeFactoryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IPancakeFactory *IPancakeFactoryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IPancakeFactory.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IPancakeFactory *IPancakeFactoryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IPancakeFactory.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IPancakeFactory *IPancakeFactoryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IPancakeFactory.Contract.contract.Transact(opts, method, params...)
+}
+
+// AllPairs is a free data retrieval call binding the contract method 0x1e3dd18b.
+//
+// Solidity: function allPairs(uint256 ) view returns(address pair)
+func (_IPancakeFactory *IPancakeFactoryCaller) AllPairs(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _IPancakeFactory.contract.Call(opts, &out, "allPairs", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// AllPairs is a free data retrieval call binding the contract method 0x1e3dd18b.
+//
+// Solidity: function allPairs(uint256 ) view returns(address pair)
+func (_IPancakeFactory *IPancakeFactorySession) AllPairs(arg0 *big.Int) (common.Address, error) {
+	return _IPancakeFactory.Contract.AllPairs(&_IPancakeFactory.CallOpts, arg0)
+}
+
+// AllPairs is a free data retrieval call binding the contract method 0x1e3dd18b.
+//
+// Solidity: function allPairs(uint256 ) view returns(address pair)
+func (_IPancakeFactory *IPancakeFactoryCallerSession) AllPairs(arg0 *big.Int) (common.Address, error) {
+	return _IPancakeFactory.Contract.AllPairs(&_IPancakeFactory.CallOpts, arg0)
+}
+
+// AllPairsLength is a free data retrieval call binding the contract method 0x574f2ba3.
+//
+// Solidity: function allPairsLength() view returns(uint256)
+func (_IPancakeFactory *IPancakeFactoryCaller) AllPairsLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IPancakeFactory.contract.Call(opts, &out, "allPairsLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// AllPairsLength is a free data retrieval call binding the contract method 0x574f2ba3.
+//
+// Solidity: function allPairsLength() view returns(uint256)
+func (_IPancakeFactory *IPancakeFactorySession) AllPairsLength() (*big.Int, error) {
+	return _IPancakeFactory.Contract.AllPairsLength(&_IPancakeFactory.CallOpts)
+}
+
+// AllPairsLength is a free data retrieval call binding the contract method 0x574f2ba3.
+//
+// Solidity: function allPairsLength() view returns(uint256)
+func (_IPancakeFactory *IPancakeFactoryCallerSession) AllPairsLength() (*big.Int, error) {
+	return _IPancakeFactory.Contract.AllPairsLength(&_IPancakeFactory.CallOpts)
+}
+
+// FeeTo is a free data retrieval call binding the contract method 0x017e7e58.
+//
+// Solidity: function feeTo() view returns(address)
+func (_IPancakeFactory *IPancakeFactoryCaller) FeeTo(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IPancakeFactory.contract.Call(opts, &out, "feeTo")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// FeeTo is a free data retrieval call binding the contract method 0x017e7e58.
+//
+// Solidity: function feeTo() view returns(address)
+func (_IPancakeFactory *IPancakeFactorySession) FeeTo() (common.Address, error) {
+	return _IPancakeFactory.Contract.FeeTo(&_IPancakeFactory.CallOpts)
+}
+
+// FeeTo is a free data retrieval call binding the contract method 0x017e7e58.
+//
+// Solidity: function feeTo() view returns(address)
+func (_IPancakeFactory *IPancakeFactoryCallerSession) FeeTo() (common.Address, error) {
+	return _IPancakeFactory.Contract.FeeTo(&_IPancakeFactory.CallOpts)
+}
+
+// FeeToSetter is a free data retrieval call binding the contract method 0x094b7415.
+//
+// Solidity: function feeToSetter() view returns(address)
+func (_IPancakeFactory *IPancakeFactoryCaller) FeeToSetter(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IPancakeFactory.contract.Call(opts, &out, "feeToSetter")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// FeeToSetter is a free data retrieval call binding the contract method 0x094b7415.
+//
+// Solidity: function feeToSetter() view returns(address)
+func (_IPancakeFactory *IPancakeFactorySession) FeeToSetter() (common.Address, error) {
+	return _IPancakeFactory.Contract.FeeToSetter(&_IPancakeFactory.CallOpts)
+}
+
+// FeeToSetter is a free data retrieval call binding the contract method 0x094b7415.
+//
+// Solidity: function feeToSetter() view returns(address)
+func (_IPancakeFactory *IPancakeFactoryCallerSession) FeeToSetter() (common.Address, error) {
+	return _IPancakeFactory.Contract.FeeToSetter(&_IPancakeFactory.CallOpts)
+}
+
+// GetPair is a free data retrieval call binding the contract method 0xe6a43905.
+//
+// Solidity: function getPair(address tokenA, address tokenB) view returns(address pair)
+func (_IPancakeFactory *IPancakeFactoryCaller) GetPair(opts *bind.CallOpts, tokenA common.Address, tokenB common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _IPancakeFactory.contract.Call(opts, &out, "getPair", tokenA, tokenB)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetPair is a free data retrieval call binding the contract method 0xe6a43905.
+//
+// Solidity: function getPair(address tokenA, address tokenB) view returns(address pair)
+func (_IPancakeFactory *IPancakeFactorySession) GetPair(tokenA common.Address, tokenB common.Address) (common.Address, error) {
+	return _IPancakeFactory.Contract.GetPair(&_IPancakeFactory.CallOpts, tokenA, tokenB)
+}
+
+// GetPair is a free data retrieval call binding the contract method 0xe6a43905.
+//
+// Solidity: function getPair(address tokenA, address tokenB) view returns(address pair)
+func (_IPancakeFactory *IPancakeFactoryCallerSession) GetPair(tokenA common.Address, tokenB common.Address) (common.Address, error) {
+	return _IPancakeFactory.Contract.GetPair(&_IPancakeFactory.CallOpts, tokenA, tokenB)
+}
+
+// CreatePair is a paid mutator transaction binding the contract method 0xc9c65396.
+//
+// Solidity: function createPair(address tokenA, address tokenB) returns(address pair)
+func (_IPancakeFactory *IPancakeFactoryTransactor) CreatePair(opts *bind.TransactOpts, tokenA common.Address, tokenB common.Address) (*types.Transaction, error) {
+	return _IPancakeFactory.contract.Transact(opts, "createPair", tokenA, tokenB)
+}
+
+// CreatePair is a paid mutator transaction binding the contract method 0xc9c65396.
+//
+// Solidity: function createPair(address tokenA, address tokenB) returns(address pair)
+func (_IPancakeFactory *IPancakeFactorySession) CreatePair(tokenA common.Address, tokenB common.Address) (*types.Transaction, error) {
+	return _IPancakeFactory.Contract.CreatePair(&_IPancakeFactory.TransactOpts, tokenA, tokenB)
+}
+
+// CreatePair is a paid mutator transaction binding the contract method 0xc9c65396.
+//
+// Solidity: function createPair(address tokenA, address tokenB) returns(address pair)
+func (_IPancakeFactory *IPancakeFactoryTransactorSession) CreatePair(tokenA common.Address, tokenB common.Address) (*types.Transaction, error) {
+	return _IPancakeFactory.Contract.CreatePair(&_IPancakeFactory.TransactOpts, tokenA, tokenB)
+}
+
+// SetFeeTo is a paid mutator transaction binding the contract method 0xf46901ed.
+//
+// Solidity: function setFeeTo(address ) returns()
+func (_IPancakeFactory *IPancakeFactoryTransactor) SetFeeTo(opts *bind.TransactOpts, arg0 common.Address) (*types.Transaction, error) {
+	return _IPancakeFactory.contract.Transact(opts, "setFeeTo", arg0)
+}
+
+// SetFeeTo is a paid mutator transaction binding the contract method 0xf46901ed.
+//
+// Solidity: function setFeeTo(address ) returns()
+func (_IPancakeFactory *IPancakeFactorySession) SetFeeTo(arg0 common.Address) (*types.Transaction, error) {
+	return _IPancakeFactory.Contract.SetFeeTo(&_IPancakeFactory.TransactOpts, arg0)
+}
+
+// SetFeeTo is a paid mutator transaction binding the contract method 0xf46901ed.
+//
+// Solidity: function setFeeTo(address ) returns()
+func (_IPancakeFactory *IPancakeFactoryTransactorSession) SetFeeTo(arg0 common.Address) (*types.Transaction, error) {
+	return _IPancakeFactory.Contract.SetFeeTo(&_IPancakeFactory.TransactOpts, arg0)
+}
+
+// SetFeeToSetter is a paid mutator transaction binding the contract method 0xa2e74af6.
+//
+// Solidity: function setFeeToSetter(address ) returns()
+func (_IPancakeFactory *IPancakeFactoryTransactor) SetFeeToSetter(opts *bind.TransactOpts, arg0 common.Address) (*types.Transaction, error) {
+	return _IPancakeFactory.contract.Transact(opts, "setFeeToSetter", arg0)
+}
+
+// SetFeeToSetter is a paid mutator transaction binding the contract method 0xa2e74af6.
+//
+// Solidity: function setFeeToSetter(address ) returns()
+func (_IPancakeFactory *IPancakeFactorySession) SetFeeToSetter(arg0 common.Address) (*types.Transaction, error) {
+	return _IPancakeFactory.Contract.SetFeeToSetter(&_IPancakeFactory.TransactOpts, arg0)
+}
+
+// SetFeeToSetter is a paid mutator transaction binding the contract method 0xa2e74af6.
+//
+// Solidity: function setFeeToSetter(address ) returns()
+func (_IPancakeFactory *IPancakeFactoryTransactorSession) SetFeeToSetter(arg0 common.Address) (*types.Transaction, error) {
+	return _IPancakeFactory.Contract.SetFeeToSetter(&_IPancakeFactory.TransactOpts, arg0)
+}
+
+// IPancakeFactoryPairCreatedIterator is returned from FilterPairCreated and is used to iterate over the raw logs and unpacked data for PairCreated events raised by the IPancakeFactory contract.
+type IPancakeFactoryPairCreatedIterator struct {
+	Event *IPancakeFactoryPairCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IPancakeFactoryPairCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IPancakeFactoryPairCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IPancakeFactoryPairCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IPancakeFactoryPairCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IPancakeFactoryPairCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IPancakeFactoryPairCreated represents a PairCreated event raised by the IPancakeFactory contract.
+type IPancakeFactoryPairCreated struct {
+	Token0 common.Address
+	Token1 common.Address
+	Pair   common.Address
+	Arg3   *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterPairCreated is a free log retrieval operation binding the contract event 0x0d3648bd0f6ba80134a33ba9275ac585d9d315f0ad8355cddefde31afa28d0e9.
+//
+// Solidity: event PairCreated(address indexed token0, address indexed token1, address pair, uint256 arg3)
+func (_IPancakeFactory *IPancakeFactoryFilterer) FilterPairCreated(opts *bind.FilterOpts, token0 []common.Address, token1 []common.Address) (*IPancakeFactoryPairCreatedIterator, error) {
+
+	var token0Rule []interface{}
+	for _, token0Item := range token0 {
+		token0Rule = append(token0Rule, token0Item)
+	}
+	var token1Rule []interface{}
+	for _, token1Item := range token1 {
+		token1Rule = append(token1Rule, token1Item)
+	}
+
+	logs, sub, err := _IPancakeFactory.contract.FilterLogs(opts, "PairCreated", token0Rule, token1Rule)
+	if err != nil {
+		return nil, err
+	}
+	return &IPancakeFactoryPairCreatedIterator{contract: _IPancakeFactory.contract, event: "PairCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchPairCreated is a free log subscription operation binding the contract event 0x0d3648bd0f6ba80134a33ba9275ac585d9d315f0ad8355cddefde31afa28d0e9.
+//
+// Solidity: event PairCreated(address indexed token0, address indexed token1, address pair, uint256 arg3)
+func (_IPancakeFactory *IPancakeFactoryFilterer) WatchPairCreated(opts *bind.WatchOpts, sink chan<- *IPancakeFactoryPairCreated, token0 []common.Address, token1 []common.Address) (event.Subscription, error) {
+
+	var token0Rule []interface{}
+	for _, token0Item := range token0 {
+		token0Rule = append(token0Rule, token0Item)
+	}
+	var token1Rule []interface{}
+	for _, token1Item := range token1 {
+		token1Rule = append(token1Rule, token1Item)
+	}
+
+	logs, sub, err := _IPancakeFactory.contract.WatchLogs(opts, "PairCreated", token0Rule, token1Rule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IPancakeFactoryPairCreated)
+				if err := _IPancakeFactory.contract.UnpackLog(event, "PairCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePairCreated is a log parse operation binding the contract event 0x0d3648bd0f6ba80134a33ba9275ac585d9d315f0ad8355cddefde31afa28d0e9.
+//
+// Solidity: event PairCreated(address indexed token0, address indexed token1, address pair, uint256 arg3)
+func (_IPancakeFactory *IPancakeFactoryFilterer) ParsePairCreated(log types.Log) (*IPancakeFactoryPairCreated, error) {
+	event := new(IPancakeFactoryPairCreated)
+	if err := _IPancakeFactory.contract.UnpackLog(event, "PairCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
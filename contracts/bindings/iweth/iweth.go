@@ -0,0 +1,243 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package iweth
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// IWETHMetaData contains all meta data concerning the IWETH contract.
+var IWETHMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"deposit\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"withdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// IWETHABI is the input ABI used to generate the binding from.
+// Deprecated: Use IWETHMetaData.ABI instead.
+var IWETHABI = IWETHMetaData.ABI
+
+// IWETH is an auto generated Go binding around an Ethereum contract.
+type IWETH struct {
+	IWETHCaller     // Read-only binding to the contract
+	IWETHTransactor // Write-only binding to the contract
+	IWETHFilterer   // Log filterer for contract events
+}
+
+// IWETHCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IWETHCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IWETHTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IWETHTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IWETHFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IWETHFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IWETHSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IWETHSession struct {
+	Contract     *IWETH            // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IWETHCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IWETHCallerSession struct {
+	Contract *IWETHCaller  // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// IWETHTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IWETHTransactorSession struct {
+	Contract     *IWETHTransactor  // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IWETHRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IWETHRaw struct {
+	Contract *IWETH // Generic contract binding to access the raw methods on
+}
+
+// IWETHCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IWETHCallerRaw struct {
+	Contract *IWETHCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IWETHTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IWETHTransactorRaw struct {
+	Contract *IWETHTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIWETH creates a new instance of IWETH, bound to a specific deployed contract.
+func NewIWETH(address common.Address, backend bind.ContractBackend) (*IWETH, error) {
+	contract, err := bindIWETH(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IWETH{IWETHCaller: IWETHCaller{contract: contract}, IWETHTransactor: IWETHTransactor{contract: contract}, IWETHFilterer: IWETHFilterer{contract: contract}}, nil
+}
+
+// NewIWETHCaller creates a new read-only instance of IWETH, bound to a specific deployed contract.
+func NewIWETHCaller(address common.Address, caller bind.ContractCaller) (*IWETHCaller, error) {
+	contract, err := bindIWETH(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IWETHCaller{contract: contract}, nil
+}
+
+// NewIWETHTransactor creates a new write-only instance of IWETH, bound to a specific deployed contract.
+func NewIWETHTransactor(address common.Address, transactor bind.ContractTransactor) (*IWETHTransactor, error) {
+	contract, err := bindIWETH(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IWETHTransactor{contract: contract}, nil
+}
+
+// NewIWETHFilterer creates a new log filterer instance of IWETH, bound to a specific deployed contract.
+func NewIWETHFilterer(address common.Address, filterer bind.ContractFilterer) (*IWETHFilterer, error) {
+	contract, err := bindIWETH(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IWETHFilterer{contract: contract}, nil
+}
+
+// bindIWETH binds a generic wrapper to an already deployed contract.
+func bindIWETH(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(IWETHABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IWETH *IWETHRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IWETH.Contract.IWETHCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IWETH *IWETHRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IWETH.Contract.IWETHTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IWETH *IWETHRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IWETH.Contract.IWETHTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IWETH *IWETHCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IWETH.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IWETH *IWETHTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IWETH.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IWETH *IWETHTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IWETH.Contract.contract.Transact(opts, method, params...)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xd0e30db0.
+//
+// Solidity: function deposit() payable returns()
+func (_IWETH *IWETHTransactor) Deposit(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IWETH.contract.Transact(opts, "deposit")
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xd0e30db0.
+//
+// Solidity: function deposit() payable returns()
+func (_IWETH *IWETHSession) Deposit() (*types.Transaction, error) {
+	return _IWETH.Contract.Deposit(&_IWETH.TransactOpts)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xd0e30db0.
+//
+// Solidity: function deposit() payable returns()
+func (_IWETH *IWETHTransactorSession) Deposit() (*types.Transaction, error) {
+	return _IWETH.Contract.Deposit(&_IWETH.TransactOpts)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_IWETH *IWETHTransactor) Transfer(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _IWETH.contract.Transact(opts, "transfer", to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_IWETH *IWETHSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _IWETH.Contract.Transfer(&_IWETH.TransactOpts, to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_IWETH *IWETHTransactorSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _IWETH.Contract.Transfer(&_IWETH.TransactOpts, to, value)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 ) returns()
+func (_IWETH *IWETHTransactor) Withdraw(opts *bind.TransactOpts, arg0 *big.Int) (*types.Transaction, error) {
+	return _IWETH.contract.Transact(opts, "withdraw", arg0)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 ) returns()
+func (_IWETH *IWETHSession) Withdraw(arg0 *big.Int) (*types.Transaction, error) {
+	return _IWETH.Contract.Withdraw(&_IWETH.TransactOpts, arg0)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 ) returns()
+func (_IWETH *IWETHTransactorSession) Withdraw(arg0 *big.Int) (*types.Transaction, error) {
+	return _IWETH.Contract.Withdraw(&_IWETH.TransactOpts, arg0)
+}
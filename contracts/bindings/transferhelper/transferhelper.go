@@ -0,0 +1,202 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package transferhelper
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// TransferHelperMetaData contains all meta data concerning the TransferHelper contract.
+var TransferHelperMetaData = &bind.MetaData{
+	ABI: "[]",
+	Bin: "0x60566023600b82828239805160001a607314601657fe5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600080fdfea26469706673582212207f85ac0eaed49fca814d4d4094339934f89277f3c42bbc0227af69c7e7058b6f64736f6c63430006060033",
+}
+
+// TransferHelperABI is the input ABI used to generate the binding from.
+// Deprecated: Use TransferHelperMetaData.ABI instead.
+var TransferHelperABI = TransferHelperMetaData.ABI
+
+// TransferHelperBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use TransferHelperMetaData.Bin instead.
+var TransferHelperBin = TransferHelperMetaData.Bin
+
+// DeployTransferHelper deploys a new Ethereum contract, binding an instance of TransferHelper to it.
+func DeployTransferHelper(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *TransferHelper, error) {
+	parsed, err := TransferHelperMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(TransferHelperBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &TransferHelper{TransferHelperCaller: TransferHelperCaller{contract: contract}, TransferHelperTransactor: TransferHelperTransactor{contract: contract}, TransferHelperFilterer: TransferHelperFilterer{contract: contract}}, nil
+}
+
+// TransferHelper is an auto generated Go binding around an Ethereum contract.
+type TransferHelper struct {
+	TransferHelperCaller     // Read-only binding to the contract
+	TransferHelperTransactor // Write-only binding to the contract
+	TransferHelperFilterer   // Log filterer for contract events
+}
+
+// TransferHelperCaller is an auto generated read-only Go binding around an Ethereum contract.
+type TransferHelperCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TransferHelperTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type TransferHelperTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TransferHelperFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type TransferHelperFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TransferHelperSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type TransferHelperSession struct {
+	Contract     *TransferHelper   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// TransferHelperCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type TransferHelperCallerSession struct {
+	Contract *TransferHelperCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// TransferHelperTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type TransferHelperTransactorSession struct {
+	Contract     *TransferHelperTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// TransferHelperRaw is an auto generated low-level Go binding around an Ethereum contract.
+type TransferHelperRaw struct {
+	Contract *TransferHelper // Generic contract binding to access the raw methods on
+}
+
+// TransferHelperCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type TransferHelperCallerRaw struct {
+	Contract *TransferHelperCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// TransferHelperTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type TransferHelperTransactorRaw struct {
+	Contract *TransferHelperTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewTransferHelper creates a new instance of TransferHelper, bound to a specific deployed contract.
+func NewTransferHelper(address common.Address, backend bind.ContractBackend) (*TransferHelper, error) {
+	contract, err := bindTransferHelper(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferHelper{TransferHelperCaller: TransferHelperCaller{contract: contract}, TransferHelperTransactor: TransferHelperTransactor{contract: contract}, TransferHelperFilterer: TransferHelperFilterer{contract: contract}}, nil
+}
+
+// NewTransferHelperCaller creates a new read-only instance of TransferHelper, bound to a specific deployed contract.
+func NewTransferHelperCaller(address common.Address, caller bind.ContractCaller) (*TransferHelperCaller, error) {
+	contract, err := bindTransferHelper(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferHelperCaller{contract: contract}, nil
+}
+
+// NewTransferHelperTransactor creates a new write-only instance of TransferHelper, bound to a specific deployed contract.
+func NewTransferHelperTransactor(address common.Address, transactor bind.ContractTransactor) (*TransferHelperTransactor, error) {
+	contract, err := bindTransferHelper(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferHelperTransactor{contract: contract}, nil
+}
+
+// NewTransferHelperFilterer creates a new log filterer instance of TransferHelper, bound to a specific deployed contract.
+func NewTransferHelperFilterer(address common.Address, filterer bind.ContractFilterer) (*TransferHelperFilterer, error) {
+	contract, err := bindTransferHelper(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferHelperFilterer{contract: contract}, nil
+}
+
+// bindTransferHelper binds a generic wrapper to an already deployed contract.
+func bindTransferHelper(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(TransferHelperABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_TransferHelper *TransferHelperRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _TransferHelper.Contract.TransferHelperCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_TransferHelper *TransferHelperRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TransferHelper.Contract.TransferHelperTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_TransferHelper *TransferHelperRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _TransferHelper.Contract.TransferHelperTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_TransferHelper *TransferHelperCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _TransferHelper.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_TransferHelper *TransferHelperTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TransferHelper.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_TransferHelper *TransferHelperTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _TransferHelper.Contract.contract.Transact(opts, method, params...)
+}
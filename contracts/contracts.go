@@ -0,0 +1,11 @@
+// Package contracts holds the Solidity sources of the PancakeSwap contracts,
+// their solc output in build and the Go bindings generated from it in
+// bindings.
+//
+// Regenerate the bindings after compiling with
+//
+//	solc --abi --bin --overwrite -o build PancakeTestnetContract.sol
+//	go generate ./contracts
+package contracts
+
+//go:generate go run ../cmd/bindgen -in build -out bindings
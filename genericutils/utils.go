@@ -2,12 +2,13 @@ package genericutils
 
 import (
 	"fmt"
-	"log"
 	"os/exec"
 	"runtime"
 )
 
-func OpenBrowser(url string) {
+// OpenBrowser opens url with the desktop browser. It fails on headless hosts
+// and unsupported platforms.
+func OpenBrowser(url string) error {
 	var err error
 
 	switch runtime.GOOS {
@@ -20,9 +21,7 @@ func OpenBrowser(url string) {
 	default:
 		err = fmt.Errorf("unsupported platform")
 	}
-	if err != nil {
-		log.Fatal(err)
-	}
+	return err
 }
 
 /*
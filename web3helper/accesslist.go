@@ -0,0 +1,121 @@
+package web3helper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var ErrAccessListUnsupported = errors.New("eth_createAccessList needs an rpc endpoint")
+
+// AccessListResult is the EIP-2930 access list of a call and the gas it uses
+// with and without it.
+type AccessListResult struct {
+	AccessList types.AccessList
+
+	GasWithList    uint64
+	GasWithoutList uint64
+}
+
+// Saves reports whether attaching the list lowers the gas of the call.
+func (r *AccessListResult) Saves() bool {
+	return r.GasWithList < r.GasWithoutList
+}
+
+// Savings returns the gas the list saves, 0 when it costs more.
+func (r *AccessListResult) Savings() uint64 {
+	if !r.Saves() {
+		return 0
+	}
+	return r.GasWithoutList - r.GasWithList
+}
+
+// SetAutoAccessList makes BuildTransaction and the sends built on it attach
+// the access list of eth_createAccessList when it lowers the gas used.
+func (w *Web3GolangHelper) SetAutoAccessList(enabled bool) {
+	w.autoAccessList = enabled
+}
+
+// CreateAccessList returns the access list the node generates for msg at the
+// pending block and estimates msg with and without it. Simulated backends do
+// not serve eth_createAccessList and return ErrAccessListUnsupported.
+func (w *Web3GolangHelper) CreateAccessList(ctx context.Context, msg ethereum.CallMsg) (*AccessListResult, error) {
+	rpcClient := w.rpcClient()
+	if rpcClient == nil {
+		return nil, ErrAccessListUnsupported
+	}
+
+	var created struct {
+		AccessList *types.AccessList `json:"accessList"`
+		GasUsed    hexutil.Uint64    `json:"gasUsed"`
+		Error      string            `json:"error"`
+	}
+	if err := rpcClient.CallContext(ctx, &created, "eth_createAccessList", toCallArg(msg), "pending"); err != nil {
+		if revert := w.DecodeRevert(err); revert != nil {
+			return nil, revert
+		}
+		return nil, err
+	}
+	if created.Error != "" {
+		err := errors.New(created.Error)
+		if revert := w.DecodeRevert(err); revert != nil {
+			return nil, revert
+		}
+		return nil, err
+	}
+
+	result := &AccessListResult{AccessList: types.AccessList{}}
+	if created.AccessList != nil {
+		result.AccessList = *created.AccessList
+	}
+
+	msg.AccessList = nil
+	gasWithout, err := w.estimateGas(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	msg.AccessList = result.AccessList
+	gasWith, err := w.estimateGas(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	result.GasWithList = gasWith
+	result.GasWithoutList = gasWithout
+	return result, nil
+}
+
+// toCallArg encodes msg for the eth_call family, including the access list
+// and dynamic fees ethclient leaves out.
+func toCallArg(msg ethereum.CallMsg) interface{} {
+	arg := map[string]interface{}{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["data"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	if msg.GasPrice != nil {
+		arg["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
+	}
+	if msg.GasFeeCap != nil {
+		arg["maxFeePerGas"] = (*hexutil.Big)(msg.GasFeeCap)
+	}
+	if msg.GasTipCap != nil {
+		arg["maxPriorityFeePerGas"] = (*hexutil.Big)(msg.GasTipCap)
+	}
+	if msg.AccessList != nil {
+		arg["accessList"] = msg.AccessList
+	}
+	return arg
+}
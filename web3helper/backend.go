@@ -0,0 +1,181 @@
+package web3helper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Backend is the node API the helper runs on. *ethclient.Client and
+// go-ethereum's backends.SimulatedBackend both implement it.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	ethereum.ChainReader
+	ethereum.ChainStateReader
+	ethereum.TransactionReader
+}
+
+// chainIDReader is implemented by backends answering eth_chainId.
+type chainIDReader interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+var _ Backend = (*ethclient.Client)(nil)
+
+// NewWeb3GolangHelperFromBackend runs the helper on backend, which serves
+// calls, transactions and subscriptions, e.g. a SimulatedBackend in offline
+// tests. chainID may be nil when the backend answers eth_chainId itself; the
+// simulated backend does not and uses 1337.
+func NewWeb3GolangHelperFromBackend(backend Backend, chainID *big.Int) (*Web3GolangHelper, error) {
+
+	if chainID == nil {
+		reader, ok := backend.(chainIDReader)
+		if !ok {
+			return nil, errors.New("backend does not report its chain id, pass it explicitly")
+		}
+
+		var err error
+		chainID, err = reader.ChainID(context.Background())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Web3GolangHelper{
+		backend:     backend,
+		accounts:    make([]*common.Address, 0),
+		chainID:     new(big.Int).Set(chainID),
+		feePriority: StandardFeePriority,
+	}, nil
+}
+
+// Backend returns the backend serving calls and transactions.
+func (w *Web3GolangHelper) Backend() Backend {
+	return w.selectClient()
+}
+
+// subscriptionBackend returns the backend serving subscriptions, nil when only
+// http endpoints are connected.
+func (w *Web3GolangHelper) subscriptionBackend() Backend {
+	if w.wsClient != nil {
+		return w.wsClient
+	}
+	if w.backend != nil {
+		return w.backend
+	}
+	return nil
+}
+
+// blockNumber returns the head block number, BlockNumber is not part of Backend.
+func (w *Web3GolangHelper) blockNumber(ctx context.Context) (uint64, error) {
+	header, err := w.selectClient().HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	return header.Number.Uint64(), nil
+}
+
+// ErrNotConnected is returned by the calls of a helper without an endpoint or
+// backend.
+var ErrNotConnected = errors.New("web3helper: not connected")
+
+// notConnected is the Backend of helpers without an endpoint or backend,
+// every call fails with ErrNotConnected.
+type notConnected struct{}
+
+var _ Backend = notConnected{}
+
+func (notConnected) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return nil, ErrNotConnected
+}
+
+func (notConnected) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return nil, ErrNotConnected
+}
+
+func (notConnected) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return nil, ErrNotConnected
+}
+
+func (notConnected) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return 0, ErrNotConnected
+}
+
+func (notConnected) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return nil, ErrNotConnected
+}
+
+func (notConnected) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return nil, ErrNotConnected
+}
+
+func (notConnected) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return 0, ErrNotConnected
+}
+
+func (notConnected) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return ErrNotConnected
+}
+
+func (notConnected) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, ErrNotConnected
+}
+
+func (notConnected) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, ErrNotConnected
+}
+
+func (notConnected) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return nil, ErrNotConnected
+}
+
+func (notConnected) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return nil, ErrNotConnected
+}
+
+func (notConnected) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return nil, ErrNotConnected
+}
+
+func (notConnected) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	return nil, ErrNotConnected
+}
+
+func (notConnected) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return nil, ErrNotConnected
+}
+
+func (notConnected) TransactionCount(ctx context.Context, blockHash common.Hash) (uint, error) {
+	return 0, ErrNotConnected
+}
+
+func (notConnected) TransactionInBlock(ctx context.Context, blockHash common.Hash, index uint) (*types.Transaction, error) {
+	return nil, ErrNotConnected
+}
+
+func (notConnected) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return nil, ErrNotConnected
+}
+
+func (notConnected) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return nil, ErrNotConnected
+}
+
+func (notConnected) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	return nil, ErrNotConnected
+}
+
+func (notConnected) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return 0, ErrNotConnected
+}
+
+func (notConnected) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	return nil, false, ErrNotConnected
+}
@@ -0,0 +1,472 @@
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/nikola43/web3golanghelper/web3helper"
+)
+
+// Submitter builds and signs the destination mint/release transaction for a
+// source event. attempt starts at 1 so fees can be bumped on retries. nonce is
+// nil for a new transaction and set when replacing a dropped one, which must
+// be signed at that nonce so only one of them can be mined.
+type Submitter func(ctx context.Context, vLog types.Log, attempt int, nonce *big.Int) (*types.Transaction, error)
+
+type Config struct {
+	// Contracts are the bridge contracts watched on the source network.
+	Contracts []string
+	// Topics restricts relaying to logs whose event signature is listed.
+	// Empty relays every log of the watched contracts.
+	Topics []common.Hash
+	// Confirmations is the number of blocks a source event must be buried
+	// under before it is relayed.
+	Confirmations uint64
+	MaxAttempts   int
+	PollInterval  time.Duration
+	// FromBlock is where the first backfill starts when the store has no
+	// checkpoint yet.
+	FromBlock uint64
+	// BackfillBlocks is the number of blocks queried per FilterLogs call of
+	// the backfill, 2000 by default.
+	BackfillBlocks uint64
+	// Logger receives the errors Run retries. Nothing is logged when nil.
+	Logger web3helper.Logger
+}
+
+// Relayer watches lock/deposit events on a source network and submits the
+// matching transaction on a destination network exactly once per event.
+type Relayer struct {
+	source      *web3helper.Web3GolangHelper
+	destination *web3helper.Web3GolangHelper
+	store       Store
+	submit      Submitter
+	config      Config
+}
+
+func NewRelayer(source, destination *web3helper.Web3GolangHelper, store Store, submit Submitter, config Config) *Relayer {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 3
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = 5 * time.Second
+	}
+	if config.BackfillBlocks == 0 {
+		config.BackfillBlocks = 2000
+	}
+
+	return &Relayer{
+		source:      source,
+		destination: destination,
+		store:       store,
+		submit:      submit,
+		config:      config,
+	}
+}
+
+// Run relays live events until ctx is done or the source subscription fails.
+// It subscribes before backfilling the events missed since the last
+// checkpoint, so events mined in between are buffered instead of lost.
+// Errors of Process are logged and retried on the next poll.
+func (r *Relayer) Run(ctx context.Context) error {
+
+	logs := make(chan types.Log)
+	sub, err := r.source.ListenBridgesEventsV2(r.config.Contracts, logs)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	backfilled := make(chan error, 1)
+	go func() {
+		backfilled <- r.backfill(ctx)
+	}()
+
+	var buffered []types.Log
+	for backfilling := true; backfilling; {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-sub.Err():
+			return err
+		case vLog := <-logs:
+			buffered = append(buffered, vLog)
+		case err := <-backfilled:
+			if err != nil {
+				return err
+			}
+			backfilling = false
+		}
+	}
+
+	for _, vLog := range buffered {
+		if err := r.record(vLog); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-sub.Err():
+			return err
+		case vLog := <-logs:
+			if err := r.record(vLog); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := r.Process(ctx); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				r.log(web3helper.WarnLogLevel, "relayer poll failed, retrying", "err", err)
+			}
+		}
+	}
+}
+
+func (r *Relayer) log(level web3helper.LogLevel, msg string, keyvals ...interface{}) {
+	if r.config.Logger != nil {
+		r.config.Logger.Log(level, msg, web3helper.Redact(keyvals)...)
+	}
+}
+
+func (r *Relayer) backfill(ctx context.Context) error {
+	checkpoint, err := r.store.Checkpoint()
+	if err != nil {
+		return err
+	}
+
+	fromBlock := checkpoint
+	if fromBlock < r.config.FromBlock {
+		fromBlock = r.config.FromBlock
+	}
+	if fromBlock == 0 {
+		return nil
+	}
+
+	header, err := r.source.Backend().HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	head := header.Number.Uint64()
+
+	addresses := make([]common.Address, 0, len(r.config.Contracts))
+	for _, contract := range r.config.Contracts {
+		addresses = append(addresses, common.HexToAddress(contract))
+	}
+
+	// later blocks are covered by the live subscription
+	for from := fromBlock; from <= head; from += r.config.BackfillBlocks {
+		to := from + r.config.BackfillBlocks - 1
+		if to > head {
+			to = head
+		}
+
+		logs, err := r.source.Backend().FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Addresses: addresses,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, vLog := range logs {
+			if err := r.record(vLog); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+const reorgError = "removed by chain reorganization"
+
+// record stores a newly seen source event as pending. Events already known
+// are ignored, which makes overlapping backfills and live logs harmless,
+// except when the event was mined again in another block: a pending record
+// takes the new log, one failed by a reorg is pending once more.
+func (r *Relayer) record(vLog types.Log) error {
+	if !r.matchesTopics(vLog) {
+		return nil
+	}
+
+	key := KeyOf(vLog)
+	existing, err := r.store.Get(key)
+	if err != nil {
+		return err
+	}
+
+	if vLog.Removed {
+		if existing != nil && existing.Status == StatusPending {
+			existing.Status = StatusFailed
+			existing.LastError = reorgError
+			existing.UpdatedAt = time.Now()
+			return r.store.Put(existing)
+		}
+		return nil
+	}
+
+	if existing != nil {
+		switch {
+		case existing.Status == StatusPending:
+			if existing.Log.BlockHash == vLog.BlockHash {
+				return nil
+			}
+		case existing.Status == StatusFailed && existing.LastError == reorgError:
+			existing.Status = StatusPending
+			existing.LastError = ""
+		default:
+			return nil
+		}
+
+		existing.Log = vLog
+		existing.UpdatedAt = time.Now()
+		if err := r.store.Put(existing); err != nil {
+			return err
+		}
+		return r.store.SetCheckpoint(vLog.BlockNumber)
+	}
+
+	if err := r.store.Put(&Record{
+		Key:       key.String(),
+		Log:       vLog,
+		Status:    StatusPending,
+		UpdatedAt: time.Now(),
+	}); err != nil {
+		return err
+	}
+
+	return r.store.SetCheckpoint(vLog.BlockNumber)
+}
+
+func (r *Relayer) matchesTopics(vLog types.Log) bool {
+	if len(r.config.Topics) == 0 {
+		return true
+	}
+	if len(vLog.Topics) == 0 {
+		return false
+	}
+
+	for _, topic := range r.config.Topics {
+		if vLog.Topics[0] == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// Process relays pending events that reached finality and tracks submitted
+// ones until they are mined. Run calls it on every poll interval.
+func (r *Relayer) Process(ctx context.Context) error {
+
+	header, err := r.source.Backend().HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	head := header.Number.Uint64()
+
+	pending, err := r.store.List(StatusPending)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range pending {
+		if record.Log.BlockNumber+r.config.Confirmations > head {
+			continue
+		}
+
+		canonical, err := r.isCanonical(ctx, record.Log)
+		if err != nil {
+			return err
+		}
+
+		if !canonical {
+			record.Status = StatusFailed
+			record.LastError = reorgError
+			record.UpdatedAt = time.Now()
+			if err := r.store.Put(record); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := r.send(ctx, record, nil); err != nil {
+			return err
+		}
+	}
+
+	submitted, err := r.store.List(StatusSubmitted)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range submitted {
+		if err := r.track(ctx, record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Relayer) isCanonical(ctx context.Context, vLog types.Log) (bool, error) {
+	receipt, err := r.source.Backend().TransactionReceipt(ctx, vLog.TxHash)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return receipt.Status == types.ReceiptStatusSuccessful && receipt.BlockHash == vLog.BlockHash, nil
+}
+
+// send signs a new destination transaction and persists it before it is
+// broadcast, so a crash in between results in a rebroadcast of the same
+// transaction instead of a second one. A non-nil nonce replaces the
+// transaction of record at that nonce.
+func (r *Relayer) send(ctx context.Context, record *Record, nonce *big.Int) error {
+	record.Attempts++
+	record.UpdatedAt = time.Now()
+
+	tx, err := r.submit(ctx, record.Log, record.Attempts, nonce)
+	if err == nil && nonce != nil && tx.Nonce() != nonce.Uint64() {
+		err = fmt.Errorf("submitter signed nonce %d, want %d", tx.Nonce(), nonce.Uint64())
+	}
+	if err != nil {
+		record.LastError = err.Error()
+		if record.Attempts >= r.config.MaxAttempts {
+			record.Status = StatusFailed
+		}
+		return r.store.Put(record)
+	}
+
+	rawTx, err := tx.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	if nonce != nil {
+		record.Replaced = append(append([]common.Hash(nil), record.Replaced...), record.DestTxHash)
+	} else {
+		record.Replaced = nil
+	}
+	record.Status = StatusSubmitted
+	record.DestTxHash = tx.Hash()
+	record.RawTx = rawTx
+	record.Rebroadcasts = 0
+	record.LastError = ""
+	if err := r.store.Put(record); err != nil {
+		return err
+	}
+
+	return r.broadcast(ctx, record, tx)
+}
+
+func (r *Relayer) broadcast(ctx context.Context, record *Record, tx *types.Transaction) error {
+	if err := r.destination.Backend().SendTransaction(ctx, tx); err != nil {
+		record.LastError = err.Error()
+		record.UpdatedAt = time.Now()
+		return r.store.Put(record)
+	}
+	return nil
+}
+
+// track completes records whose destination transaction was mined. Those
+// still in the destination pool are left alone. Dropped ones are rebroadcast
+// up to MaxAttempts times, then replaced at the same nonce, unless one of the
+// transactions they replaced was mined meanwhile.
+func (r *Relayer) track(ctx context.Context, record *Record) error {
+	receipt, err := r.receipt(ctx, record.DestTxHash)
+	if err != nil {
+		if !errors.Is(err, ethereum.NotFound) {
+			return err
+		}
+
+		if _, isPending, err := r.destination.Backend().TransactionByHash(ctx, record.DestTxHash); err == nil && isPending {
+			return nil
+		} else if err != nil && !errors.Is(err, ethereum.NotFound) {
+			return err
+		}
+
+		for _, txHash := range record.Replaced {
+			receipt, err = r.receipt(ctx, txHash)
+			if err == nil {
+				record.DestTxHash = txHash
+				break
+			}
+			if !errors.Is(err, ethereum.NotFound) {
+				return err
+			}
+		}
+	}
+	if receipt == nil {
+		return r.rebroadcast(ctx, record)
+	}
+
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		record.Status = StatusCompleted
+		record.LastError = ""
+		record.Replaced = nil
+		record.UpdatedAt = time.Now()
+		return r.store.Put(record)
+	}
+
+	// the nonce was used, the next transaction takes a new one
+	return r.resend(ctx, record, fmt.Sprintf("destination tx %s reverted", record.DestTxHash.Hex()), nil)
+}
+
+func (r *Relayer) receipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	receipt, err := r.destination.Backend().TransactionReceipt(ctx, txHash)
+	if err == nil && receipt == nil {
+		err = ethereum.NotFound
+	}
+	return receipt, err
+}
+
+// rebroadcast sends the dropped RawTx of record again, or replaces it at its
+// nonce once it was rebroadcast MaxAttempts times.
+func (r *Relayer) rebroadcast(ctx context.Context, record *Record) error {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(record.RawTx); err != nil {
+		return fmt.Errorf("record %s: %w", record.Key, err)
+	}
+
+	if record.Rebroadcasts >= r.config.MaxAttempts {
+		return r.resend(ctx, record, fmt.Sprintf("destination tx %s dropped", record.DestTxHash.Hex()), new(big.Int).SetUint64(tx.Nonce()))
+	}
+
+	record.Rebroadcasts++
+	record.UpdatedAt = time.Now()
+	if err := r.store.Put(record); err != nil {
+		return err
+	}
+	return r.broadcast(ctx, record, tx)
+}
+
+// resend signs a new destination transaction for record, at nonce when not
+// nil, or fails it once MaxAttempts transactions were signed.
+func (r *Relayer) resend(ctx context.Context, record *Record, reason string, nonce *big.Int) error {
+	record.LastError = reason
+	if record.Attempts >= r.config.MaxAttempts {
+		record.Status = StatusFailed
+		record.UpdatedAt = time.Now()
+		return r.store.Put(record)
+	}
+
+	return r.send(ctx, record, nonce)
+}
@@ -0,0 +1,352 @@
+package bridge
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/nikola43/web3golanghelper/web3helper/pancaketest"
+)
+
+// env relays Transfer events of a mock token to transfers of the buyer on
+// the same simulated chain.
+type env struct {
+	h         *pancaketest.Harness
+	token     *pancaketest.Token
+	key       *ecdsa.PrivateKey
+	fromBlock uint64
+
+	// nonces holds the nonce argument of every Submitter call.
+	nonces []*big.Int
+}
+
+func newEnv(t *testing.T) *env {
+	t.Helper()
+
+	h, err := pancaketest.New(pancaketest.TokenConfig{Symbol: "SRC"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { h.Close() })
+
+	key, err := crypto.HexToECDSA(h.BuyerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header, err := h.Backend.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &env{h: h, token: h.Token("SRC"), key: key, fromBlock: header.Number.Uint64() + 1}
+}
+
+func (e *env) submit(ctx context.Context, vLog types.Log, attempt int, nonce *big.Int) (*types.Transaction, error) {
+	e.nonces = append(e.nonces, nonce)
+
+	if nonce == nil {
+		pending, err := e.h.Backend.PendingNonceAt(ctx, e.h.Buyer)
+		if err != nil {
+			return nil, err
+		}
+		nonce = new(big.Int).SetUint64(pending)
+	}
+	gasPrice, err := e.h.Backend.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gasPrice.Mul(gasPrice, big.NewInt(int64(attempt)))
+
+	recipient := common.BytesToAddress(vLog.Topics[2].Bytes())
+	return types.SignTx(types.NewTransaction(nonce.Uint64(), recipient, big.NewInt(1), 21000, gasPrice, nil), types.LatestSignerForChainID(pancaketest.ChainID), e.key)
+}
+
+func (e *env) relayer(store Store, maxAttempts int) *Relayer {
+	return NewRelayer(e.h.Helper, e.h.Helper, store, e.submit, Config{
+		Contracts:   []string{e.token.Address.Hex()},
+		MaxAttempts: maxAttempts,
+		FromBlock:   e.fromBlock,
+	})
+}
+
+// deposit mints tokens and returns the Transfer event.
+func (e *env) deposit(t *testing.T) types.Log {
+	t.Helper()
+
+	if err := e.token.Mint(common.HexToAddress("0x00000000000000000000000000000000000000dd"), big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	header, err := e.h.Backend.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e.logIn(t, header.Hash())
+}
+
+func (e *env) logIn(t *testing.T, blockHash common.Hash) types.Log {
+	t.Helper()
+
+	logs, err := e.h.Backend.FilterLogs(context.Background(), ethereum.FilterQuery{
+		BlockHash: &blockHash,
+		Addresses: []common.Address{e.token.Address},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 1 {
+		t.Fatalf("block %s has %d logs, want 1", blockHash.Hex(), len(logs))
+	}
+	return logs[0]
+}
+
+// reorg replaces the block of vLog by a longer side chain that mines its
+// transaction one block later, and returns the new log.
+func (e *env) reorg(t *testing.T, vLog types.Log) types.Log {
+	t.Helper()
+	ctx := context.Background()
+
+	tx, _, err := e.h.Backend.TransactionByHash(ctx, vLog.TxHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header, err := e.h.Backend.HeaderByHash(ctx, vLog.BlockHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := e.h.Backend.Fork(ctx, header.ParentHash); err != nil {
+		t.Fatal(err)
+	}
+	e.h.Commit()
+	if err := e.h.Backend.SendTransaction(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	e.h.Commit()
+
+	receipt, err := e.h.Backend.TransactionReceipt(ctx, vLog.TxHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e.logIn(t, receipt.BlockHash)
+}
+
+func get(t *testing.T, store Store, vLog types.Log) *Record {
+	t.Helper()
+
+	record, err := store.Get(KeyOf(vLog))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record == nil {
+		t.Fatalf("no record of %s", KeyOf(vLog))
+	}
+	return record
+}
+
+func process(t *testing.T, r *Relayer) {
+	t.Helper()
+
+	if err := r.Process(context.Background()); err != nil {
+		t.Fatalf("Process: %v", err)
+	}
+}
+
+func TestRelayerDedup(t *testing.T) {
+	e := newEnv(t)
+	store := NewMemoryStore()
+	r := e.relayer(store, 3)
+	ctx := context.Background()
+
+	vLog := e.deposit(t)
+	for i := 0; i < 2; i++ {
+		if err := r.backfill(ctx); err != nil {
+			t.Fatalf("backfill: %v", err)
+		}
+		if err := r.record(vLog); err != nil {
+			t.Fatalf("record: %v", err)
+		}
+	}
+	if pending, _ := store.List(StatusPending); len(pending) != 1 {
+		t.Fatalf("%d pending records, want 1", len(pending))
+	}
+
+	process(t, r)
+	process(t, r)
+	e.h.Commit()
+	process(t, r)
+
+	if err := r.backfill(ctx); err != nil {
+		t.Fatalf("backfill: %v", err)
+	}
+	process(t, r)
+
+	if record := get(t, store, vLog); record.Status != StatusCompleted {
+		t.Fatalf("status %s, want %s", record.Status, StatusCompleted)
+	}
+	if len(e.nonces) != 1 {
+		t.Fatalf("submitted %d transactions, want 1", len(e.nonces))
+	}
+}
+
+func TestRelayerReorg(t *testing.T) {
+	e := newEnv(t)
+	store := NewMemoryStore()
+	r := e.relayer(store, 3)
+
+	vLog := e.deposit(t)
+	if err := r.record(vLog); err != nil {
+		t.Fatal(err)
+	}
+
+	remined := e.reorg(t, vLog)
+	removed := vLog
+	removed.Removed = true
+	if err := r.record(removed); err != nil {
+		t.Fatal(err)
+	}
+	if record := get(t, store, vLog); record.Status != StatusFailed || record.LastError != reorgError {
+		t.Fatalf("after the removal: status %s %q, want failed by the reorg", record.Status, record.LastError)
+	}
+
+	if err := r.record(remined); err != nil {
+		t.Fatal(err)
+	}
+	process(t, r)
+
+	record := get(t, store, vLog)
+	if record.Status != StatusSubmitted || record.Log.BlockHash != remined.BlockHash {
+		t.Fatalf("status %s in block %s, want submitted in %s", record.Status, record.Log.BlockHash.Hex(), remined.BlockHash.Hex())
+	}
+}
+
+func TestRelayerReminedWhilePending(t *testing.T) {
+	e := newEnv(t)
+	store := NewMemoryStore()
+	r := e.relayer(store, 3)
+
+	vLog := e.deposit(t)
+	if err := r.record(vLog); err != nil {
+		t.Fatal(err)
+	}
+
+	// the removal of vLog is never seen
+	if err := r.record(e.reorg(t, vLog)); err != nil {
+		t.Fatal(err)
+	}
+	process(t, r)
+
+	if record := get(t, store, vLog); record.Status != StatusSubmitted {
+		t.Fatalf("status %s %q, want submitted", record.Status, record.LastError)
+	}
+}
+
+func TestRelayerDroppedTx(t *testing.T) {
+	e := newEnv(t)
+	store := NewMemoryStore()
+	r := e.relayer(store, 2)
+	ctx := context.Background()
+
+	vLog := e.deposit(t)
+	if err := r.backfill(ctx); err != nil {
+		t.Fatal(err)
+	}
+	process(t, r)
+
+	first := get(t, store, vLog)
+	dropped := new(types.Transaction)
+	if err := dropped.UnmarshalBinary(first.RawTx); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 1; i <= 2; i++ {
+		e.h.Backend.Rollback()
+		process(t, r)
+
+		record := get(t, store, vLog)
+		if record.Rebroadcasts != i || record.DestTxHash != dropped.Hash() {
+			t.Fatalf("rebroadcast %d: %d rebroadcasts of %s", i, record.Rebroadcasts, record.DestTxHash.Hex())
+		}
+		if _, isPending, err := e.h.Backend.TransactionByHash(ctx, dropped.Hash()); err != nil || !isPending {
+			t.Fatalf("rebroadcast %d: transaction not pending: %v", i, err)
+		}
+	}
+
+	e.h.Backend.Rollback()
+	process(t, r)
+
+	replacement := get(t, store, vLog)
+	if len(e.nonces) != 2 || e.nonces[1] == nil || e.nonces[1].Uint64() != dropped.Nonce() {
+		t.Fatalf("replacement signed with nonces %v, want %d", e.nonces, dropped.Nonce())
+	}
+	if replacement.DestTxHash == dropped.Hash() || len(replacement.Replaced) != 1 || replacement.Replaced[0] != dropped.Hash() {
+		t.Fatalf("replacement %s replaced %v, want %s", replacement.DestTxHash.Hex(), replacement.Replaced, dropped.Hash().Hex())
+	}
+
+	// the dropped transaction is mined instead of its replacement
+	e.h.Backend.Rollback()
+	if err := e.h.Backend.SendTransaction(ctx, dropped); err != nil {
+		t.Fatal(err)
+	}
+	e.h.Commit()
+	process(t, r)
+
+	if record := get(t, store, vLog); record.Status != StatusCompleted || record.DestTxHash != dropped.Hash() {
+		t.Fatalf("status %s with %s, want completed with %s", record.Status, record.DestTxHash.Hex(), dropped.Hash().Hex())
+	}
+	if len(e.nonces) != 2 {
+		t.Fatalf("submitted %d transactions, want 2", len(e.nonces))
+	}
+}
+
+func TestRelayerRestart(t *testing.T) {
+	e := newEnv(t)
+	path := filepath.Join(t.TempDir(), "relayer.json")
+	ctx := context.Background()
+
+	store, err := NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := e.relayer(store, 3)
+
+	vLog := e.deposit(t)
+	if err := r.backfill(ctx); err != nil {
+		t.Fatal(err)
+	}
+	process(t, r)
+	submitted := get(t, store, vLog)
+
+	// the destination node loses the transaction while the relayer is down
+	e.h.Backend.Rollback()
+
+	reopened, err := NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if checkpoint, err := reopened.Checkpoint(); err != nil || checkpoint != vLog.BlockNumber {
+		t.Fatalf("checkpoint = %d, %v, want %d", checkpoint, err, vLog.BlockNumber)
+	}
+	r = e.relayer(reopened, 3)
+
+	if err := r.backfill(ctx); err != nil {
+		t.Fatal(err)
+	}
+	process(t, r)
+	e.h.Commit()
+	process(t, r)
+
+	record := get(t, reopened, vLog)
+	if record.Status != StatusCompleted || record.DestTxHash != submitted.DestTxHash {
+		t.Fatalf("status %s with %s, want completed with %s", record.Status, record.DestTxHash.Hex(), submitted.DestTxHash.Hex())
+	}
+	if len(e.nonces) != 1 {
+		t.Fatalf("submitted %d transactions, want 1", len(e.nonces))
+	}
+}
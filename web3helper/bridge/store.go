@@ -0,0 +1,192 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+type Status string
+
+const (
+	// StatusPending events are waiting for source finality.
+	StatusPending Status = "pending"
+	// StatusSubmitted events have a signed destination tx that was broadcast.
+	StatusSubmitted Status = "submitted"
+	// StatusCompleted events were executed on the destination network.
+	StatusCompleted Status = "completed"
+	// StatusFailed events exhausted their attempts or were reorged out.
+	StatusFailed Status = "failed"
+)
+
+// EventKey identifies a source event. It is the idempotency key of the relayer.
+type EventKey struct {
+	TxHash   common.Hash
+	LogIndex uint
+}
+
+func KeyOf(vLog types.Log) EventKey {
+	return EventKey{TxHash: vLog.TxHash, LogIndex: vLog.Index}
+}
+
+func (k EventKey) String() string {
+	return fmt.Sprintf("%s:%d", k.TxHash.Hex(), k.LogIndex)
+}
+
+// Record is the persisted processing state of one source event. RawTx holds
+// the signed destination transaction so it can be rebroadcast, never re-signed,
+// after a restart. Rebroadcasts counts the broadcasts of RawTx after the
+// destination pool dropped it. Replaced holds the hashes of the dropped
+// transactions RawTx replaced at the same nonce, any of which may still be
+// mined instead of it.
+type Record struct {
+	Key          string        `json:"key"`
+	Log          types.Log     `json:"log"`
+	Status       Status        `json:"status"`
+	Attempts     int           `json:"attempts"`
+	Rebroadcasts int           `json:"rebroadcasts,omitempty"`
+	DestTxHash   common.Hash   `json:"destTxHash"`
+	RawTx        hexutil.Bytes `json:"rawTx,omitempty"`
+	Replaced     []common.Hash `json:"replaced,omitempty"`
+	LastError    string        `json:"lastError,omitempty"`
+	UpdatedAt    time.Time     `json:"updatedAt"`
+}
+
+// Store persists relayer state. Get returns nil, nil for unknown keys.
+type Store interface {
+	Get(key EventKey) (*Record, error)
+	Put(record *Record) error
+	List(status Status) ([]*Record, error)
+	Checkpoint() (uint64, error)
+	SetCheckpoint(block uint64) error
+}
+
+type memoryState struct {
+	Records    map[string]*Record `json:"records"`
+	Checkpoint uint64             `json:"checkpoint"`
+}
+
+// MemoryStore keeps relayer state in memory only.
+type MemoryStore struct {
+	mu    sync.Mutex
+	state memoryState
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{state: memoryState{Records: make(map[string]*Record)}}
+}
+
+func (s *MemoryStore) Get(key EventKey) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.state.Records[key.String()]
+	if !ok {
+		return nil, nil
+	}
+	copied := *record
+	return &copied, nil
+}
+
+func (s *MemoryStore) Put(record *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	copied := *record
+	s.state.Records[record.Key] = &copied
+	return nil
+}
+
+func (s *MemoryStore) List(status Status) ([]*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records := make([]*Record, 0)
+	for _, record := range s.state.Records {
+		if record.Status == status {
+			copied := *record
+			records = append(records, &copied)
+		}
+	}
+	return records, nil
+}
+
+func (s *MemoryStore) Checkpoint() (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.state.Checkpoint, nil
+}
+
+func (s *MemoryStore) SetCheckpoint(block uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if block > s.state.Checkpoint {
+		s.state.Checkpoint = block
+	}
+	return nil
+}
+
+// FileStore is a MemoryStore that rewrites a JSON file after every change.
+type FileStore struct {
+	*MemoryStore
+	path string
+}
+
+func NewFileStore(path string) (*FileStore, error) {
+	store := &FileStore{MemoryStore: NewMemoryStore(), path: path}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return store, nil
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(content, &store.state); err != nil {
+		return nil, err
+	}
+	if store.state.Records == nil {
+		store.state.Records = make(map[string]*Record)
+	}
+
+	return store, nil
+}
+
+func (s *FileStore) Put(record *Record) error {
+	if err := s.MemoryStore.Put(record); err != nil {
+		return err
+	}
+	return s.flush()
+}
+
+func (s *FileStore) SetCheckpoint(block uint64) error {
+	if err := s.MemoryStore.SetCheckpoint(block); err != nil {
+		return err
+	}
+	return s.flush()
+}
+
+func (s *FileStore) flush() error {
+	s.mu.Lock()
+	content, err := json.MarshalIndent(s.state, "", " ")
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	tmpPath := s.path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, content, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, s.path)
+}
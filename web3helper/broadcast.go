@@ -0,0 +1,203 @@
+package web3helper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// knownTxMessages are the errors nodes return for transactions already in
+// their pool, which broadcasts count as accepted.
+var knownTxMessages = []string{
+	"already known",
+	"known transaction",
+	"already imported",
+	"already exists",
+}
+
+// broadcastTimeout bounds the posts of a broadcast, which outlive the
+// context of BroadcastTransaction.
+const broadcastTimeout = 30 * time.Second
+
+// BroadcastResult is the first endpoint that accepted a transaction.
+type BroadcastResult struct {
+	TxHash common.Hash
+
+	// Endpoint is the host of the endpoint, without paths carrying api keys.
+	Endpoint string
+
+	// AlreadyKnown is set when the endpoint had the transaction already.
+	AlreadyKnown bool
+}
+
+// BroadcastError is a transaction every endpoint rejected, keyed by host,
+// followed by the index of the endpoint when several share it.
+type BroadcastError struct {
+	Errors map[string]error
+
+	first error
+}
+
+func (e *BroadcastError) Error() string {
+	endpoints := make([]string, 0, len(e.Errors))
+	for endpoint := range e.Errors {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+
+	messages := make([]string, len(endpoints))
+	for i, endpoint := range endpoints {
+		messages[i] = endpoint + ": " + e.Errors[endpoint].Error()
+	}
+	return "broadcast rejected by every endpoint: " + strings.Join(messages, "; ")
+}
+
+// Unwrap returns the error of the first endpoint, the primary one.
+func (e *BroadcastError) Unwrap() error {
+	return e.first
+}
+
+// SetParallelBroadcast makes every send go through BroadcastTransaction.
+func (w *Web3GolangHelper) SetParallelBroadcast(enabled bool) {
+	w.parallelBroadcast = enabled
+}
+
+// SetBroadcastUrls sets the endpoints of BroadcastTransaction, by default
+// the HTTP endpoints of the network with their fallbacks.
+func (w *Web3GolangHelper) SetBroadcastUrls(urls []string) {
+	w.broadcastMu.Lock()
+	defer w.broadcastMu.Unlock()
+
+	w.broadcastUrls = urls
+	w.broadcastClients = nil
+}
+
+// broadcastEndpoints returns the clients of the broadcast endpoints, dialing
+// them on first use.
+func (w *Web3GolangHelper) broadcastEndpoints() ([]string, []*rpc.Client, error) {
+	w.broadcastMu.Lock()
+	defer w.broadcastMu.Unlock()
+
+	urls := w.broadcastUrls
+	if urls == nil && w.network != nil && w.backend == nil {
+		urls = w.network.HttpUrls()
+	}
+
+	if w.broadcastClients == nil {
+		w.broadcastClients = make(map[string]*rpc.Client)
+	}
+
+	clients := make([]*rpc.Client, 0, len(urls))
+	for _, rpcUrl := range urls {
+		client, ok := w.broadcastClients[rpcUrl]
+		if !ok {
+			var err error
+			if client, err = newMeteredHttpClient(rpcUrl, w.metrics); err != nil {
+				return nil, nil, fmt.Errorf("broadcast endpoint %s: %w", endpointLabel(rpcUrl), err)
+			}
+			w.broadcastClients[rpcUrl] = client
+		}
+		clients = append(clients, client)
+	}
+	return urls, clients, nil
+}
+
+// BroadcastTransaction sends a signed transaction, e.g. one of SignTx, to
+// every broadcast endpoint at once. It returns when the first endpoint
+// accepts it or ctx ends, the posts to the other endpoints carry on in the
+// background for up to broadcastTimeout. Endpoints that already know the
+// transaction count as accepting it. Helpers without endpoints, like those of
+// backends, send it to the connected node only.
+func (w *Web3GolangHelper) BroadcastTransaction(ctx context.Context, tx *types.Transaction) (*BroadcastResult, error) {
+	urls, clients, err := w.broadcastEndpoints()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(clients) == 0 {
+		err := w.selectClient().SendTransaction(ctx, tx)
+		if err != nil && !isKnownTxError(err) {
+			return nil, err
+		}
+		return &BroadcastResult{TxHash: tx.Hash(), AlreadyKnown: err != nil}, nil
+	}
+
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	type response struct {
+		index int
+		err   error
+	}
+	responses := make(chan response, len(clients))
+
+	// the posts outlive ctx so every endpoint gets the transaction
+	sendCtx, cancel := context.WithTimeout(context.Background(), broadcastTimeout)
+	var sending sync.WaitGroup
+	for i, client := range clients {
+		sending.Add(1)
+		go func(i int, client *rpc.Client) {
+			defer sending.Done()
+			err := client.CallContext(sendCtx, nil, "eth_sendRawTransaction", hexutil.Encode(raw))
+			responses <- response{index: i, err: err}
+		}(i, client)
+	}
+	go func() {
+		sending.Wait()
+		cancel()
+	}()
+
+	errs := make([]error, len(clients))
+	for range clients {
+		var response response
+		select {
+		case response = <-responses:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		endpoint := endpointLabel(urls[response.index])
+
+		if response.err == nil || isKnownTxError(response.err) {
+			w.log(DebugLogLevel, "transaction broadcast", "txHash", tx.Hash(), "endpoint", endpoint)
+			return &BroadcastResult{
+				TxHash:       tx.Hash(),
+				Endpoint:     endpoint,
+				AlreadyKnown: response.err != nil,
+			}, nil
+		}
+
+		w.log(WarnLogLevel, "broadcast endpoint rejected transaction", "txHash", tx.Hash(), "endpoint", endpoint, "err", response.err)
+		errs[response.index] = response.err
+	}
+
+	broadcastErr := &BroadcastError{Errors: make(map[string]error, len(errs)), first: errs[0]}
+	for i, err := range errs {
+		endpoint := endpointLabel(urls[i])
+		if _, ok := broadcastErr.Errors[endpoint]; ok {
+			endpoint = fmt.Sprintf("%s#%d", endpoint, i)
+		}
+		broadcastErr.Errors[endpoint] = err
+	}
+	return nil, broadcastErr
+}
+
+func isKnownTxError(err error) bool {
+	message := strings.ToLower(err.Error())
+	for _, known := range knownTxMessages {
+		if strings.Contains(message, known) {
+			return true
+		}
+	}
+	return false
+}
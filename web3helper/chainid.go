@@ -0,0 +1,91 @@
+package web3helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+var ErrChainIDMismatch = errors.New("chain id mismatch")
+
+// VerifyChainID fetches eth_chainId from every connected endpoint and fails if
+// any of them differs from expected. On success the chain ID is cached and
+// used by every signer of the helper.
+func (w *Web3GolangHelper) VerifyChainID(ctx context.Context, expected uint64) error {
+
+	clients := make(map[string]chainIDReader)
+	if w.httpClient != nil {
+		clients["http"] = w.httpClient
+	}
+	if w.wsClient != nil {
+		clients["websocket"] = w.wsClient
+	}
+	if reader, ok := w.backend.(chainIDReader); ok {
+		clients["backend"] = reader
+	}
+
+	for name, client := range clients {
+		chainID, err := client.ChainID(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !chainID.IsUint64() || chainID.Uint64() != expected {
+			return fmt.Errorf("%w: expected %d, %s endpoint returned %s", ErrChainIDMismatch, expected, name, chainID)
+		}
+	}
+
+	w.chainIDMu.Lock()
+	defer w.chainIDMu.Unlock()
+
+	w.chainID = new(big.Int).SetUint64(expected)
+	return nil
+}
+
+// cachedChainID returns the chain ID fetched on connect, querying eth_chainId
+// only the first time for helpers created without a network.
+func (w *Web3GolangHelper) cachedChainID(ctx context.Context) (*big.Int, error) {
+
+	w.chainIDMu.Lock()
+	defer w.chainIDMu.Unlock()
+
+	if w.chainID == nil {
+		reader, ok := w.selectClient().(chainIDReader)
+		if !ok {
+			return nil, errors.New("backend does not report its chain id")
+		}
+
+		chainID, err := reader.ChainID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		w.chainID = chainID
+	}
+
+	return new(big.Int).Set(w.chainID), nil
+}
+
+// checkClientChainID rejects a client added after the chain ID was cached when
+// it points to another chain.
+func (w *Web3GolangHelper) checkClientChainID(client *ethclient.Client) error {
+
+	w.chainIDMu.Lock()
+	defer w.chainIDMu.Unlock()
+
+	if w.chainID == nil {
+		return nil
+	}
+
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if chainID.Cmp(w.chainID) != 0 {
+		return fmt.Errorf("%w: expected %s, added endpoint returned %s", ErrChainIDMismatch, w.chainID, chainID)
+	}
+	return nil
+}
@@ -0,0 +1,561 @@
+package web3helper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/shopspring/decimal"
+)
+
+var ErrUnknownEvent = errors.New("log does not match any event of the contract")
+
+// Contract calls any contract by method name through its ABI, without a
+// generated binding. Arguments are coerced to the ABI types with
+// CoerceArguments, so strings and decimals taken from a CLI or a script work.
+type Contract struct {
+	Address common.Address
+	ABI     abi.ABI
+
+	helper *Web3GolangHelper
+}
+
+// DecodedEvent is a contract log decoded against its event.
+type DecodedEvent struct {
+	Name      string
+	Signature string
+	Arguments []DecodedArgument
+	Log       types.Log
+}
+
+// Arg returns the value of the named argument, or nil if it is not present.
+// Indexed strings, bytes and arrays only hold the hash of their value.
+func (e *DecodedEvent) Arg(name string) interface{} {
+	for _, arg := range e.Arguments {
+		if arg.Name == name {
+			return arg.Value
+		}
+	}
+	return nil
+}
+
+// BindContract returns a client of the contract at address. abiJSON is either
+// an ABI array or a Hardhat, Foundry or Truffle artifact holding one, so a
+// //go:embed string works as well. Its custom errors are registered for
+// revert decoding.
+func (w *Web3GolangHelper) BindContract(address common.Address, abiJSON string) (*Contract, error) {
+	parsed, err := ParseABI(abiJSON)
+	if err != nil {
+		return nil, err
+	}
+	w.registerErrors(parsed)
+
+	return &Contract{
+		Address: address,
+		ABI:     parsed,
+		helper:  w,
+	}, nil
+}
+
+// BindContractFile is BindContract with the ABI read from path.
+func (w *Web3GolangHelper) BindContractFile(address common.Address, path string) (*Contract, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := w.BindContract(address, string(content))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return contract, nil
+}
+
+// ParseABI parses an ABI array or the abi field of a compiler artifact.
+func ParseABI(abiJSON string) (abi.ABI, error) {
+	trimmed := strings.TrimSpace(abiJSON)
+	if strings.HasPrefix(trimmed, "{") {
+		var artifact struct {
+			Abi json.RawMessage `json:"abi"`
+		}
+		if err := json.Unmarshal([]byte(trimmed), &artifact); err != nil {
+			return abi.ABI{}, err
+		}
+		if len(artifact.Abi) == 0 {
+			return abi.ABI{}, errors.New("artifact has no abi")
+		}
+		trimmed = string(artifact.Abi)
+	}
+
+	return abi.JSON(strings.NewReader(trimmed))
+}
+
+// Pack returns the calldata of method called with args.
+func (c *Contract) Pack(method string, args ...interface{}) ([]byte, error) {
+	m, ok := c.ABI.Methods[method]
+	if !ok {
+		return nil, fmt.Errorf("contract has no method %q", method)
+	}
+
+	values, err := CoerceArguments(m.Inputs, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", method, err)
+	}
+
+	return c.ABI.Pack(method, values...)
+}
+
+// Call runs a read only method at the latest block and returns its outputs.
+func (c *Contract) Call(ctx context.Context, method string, args ...interface{}) ([]interface{}, error) {
+	data, err := c.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := c.helper.selectClient().CallContract(ctx, ethereum.CallMsg{
+		To:   &c.Address,
+		Data: data,
+	}, nil)
+	if revert := c.helper.DecodeRevert(err); revert != nil {
+		return nil, revert
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ABI.Unpack(method, output)
+}
+
+// Transact sends a transaction calling method, signed with pk.
+func (c *Contract) Transact(ctx context.Context, pk string, method string, args ...interface{}) (*types.Transaction, error) {
+	return c.TransactWithValue(ctx, pk, nil, method, args...)
+}
+
+// TransactWithValue is Transact for payable methods, sending value wei along.
+func (c *Contract) TransactWithValue(ctx context.Context, pk string, value *big.Int, method string, args ...interface{}) (*types.Transaction, error) {
+	data, err := c.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.helper.transact(ctx, pk, c.Address, value, data)
+}
+
+// EstimateGas returns the gas from would use calling method with value wei,
+// which may be nil.
+func (c *Contract) EstimateGas(ctx context.Context, from common.Address, value *big.Int, method string, args ...interface{}) (uint64, error) {
+	data, err := c.Pack(method, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.helper.estimateGas(ctx, ethereum.CallMsg{
+		From:  from,
+		To:    &c.Address,
+		Value: value,
+		Data:  data,
+	})
+}
+
+// EventTopic returns the topic identifying the event name in logs.
+func (c *Contract) EventTopic(name string) (common.Hash, error) {
+	event, ok := c.ABI.Events[name]
+	if !ok {
+		return common.Hash{}, fmt.Errorf("contract has no event %q", name)
+	}
+	return event.ID, nil
+}
+
+// DecodeEvent decodes log as the event name.
+func (c *Contract) DecodeEvent(name string, log types.Log) (*DecodedEvent, error) {
+	event, ok := c.ABI.Events[name]
+	if !ok {
+		return nil, fmt.Errorf("contract has no event %q", name)
+	}
+
+	topics := log.Topics
+	if !event.Anonymous {
+		if len(topics) == 0 || topics[0] != event.ID {
+			return nil, fmt.Errorf("log is not a %s event", name)
+		}
+		topics = topics[1:]
+	}
+
+	values, err := event.Inputs.NonIndexed().Unpack(log.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decode %s: %w", name, err)
+	}
+
+	decoded := &DecodedEvent{
+		Name:      event.Name,
+		Signature: event.Sig,
+		Arguments: make([]DecodedArgument, 0, len(event.Inputs)),
+		Log:       log,
+	}
+
+	for _, input := range event.Inputs {
+		var value interface{}
+		if input.Indexed {
+			if len(topics) == 0 {
+				return nil, fmt.Errorf("decode %s: missing topic for %s", name, input.Name)
+			}
+
+			parsed := make(map[string]interface{})
+			if err := abi.ParseTopicsIntoMap(parsed, abi.Arguments{input}, topics[:1]); err != nil {
+				return nil, fmt.Errorf("decode %s: %w", name, err)
+			}
+			value, topics = parsed[input.Name], topics[1:]
+		} else {
+			value, values = values[0], values[1:]
+		}
+
+		decoded.Arguments = append(decoded.Arguments, DecodedArgument{
+			Name:  input.Name,
+			Type:  input.Type.String(),
+			Value: value,
+		})
+	}
+
+	return decoded, nil
+}
+
+// DecodeLog decodes log against the event its first topic identifies.
+// Anonymous events can only be decoded by name with DecodeEvent.
+func (c *Contract) DecodeLog(log types.Log) (*DecodedEvent, error) {
+	if len(log.Topics) == 0 {
+		return nil, ErrUnknownEvent
+	}
+
+	event, err := c.ABI.EventByID(log.Topics[0])
+	if err != nil {
+		return nil, ErrUnknownEvent
+	}
+	return c.DecodeEvent(event.Name, log)
+}
+
+// FilterEvents returns the name events the contract emitted between
+// fromBlock and toBlock, a nil toBlock meaning the latest block.
+func (c *Contract) FilterEvents(ctx context.Context, name string, fromBlock *big.Int, toBlock *big.Int) ([]*DecodedEvent, error) {
+	topic, err := c.EventTopic(name)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := c.helper.selectClient().FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+		Addresses: []common.Address{c.Address},
+		Topics:    [][]common.Hash{{topic}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*DecodedEvent, 0, len(logs))
+	for _, log := range logs {
+		event, err := c.DecodeEvent(name, log)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// CoerceArguments converts values to the Go types arguments pack from.
+// Besides the exact types it accepts:
+//
+//   - integers as Go integers, *big.Int, decimal.Decimal, integral floats
+//     and strings in decimal, 0x hex or exponent notation ("1e18")
+//   - addresses, bytes and fixed bytes as hex strings
+//   - bools as strings parsed by strconv.ParseBool
+//   - arrays as slices, JSON arrays or comma separated strings
+//   - tuples as maps keyed by component name, slices or JSON
+func CoerceArguments(arguments abi.Arguments, values ...interface{}) ([]interface{}, error) {
+	if len(values) != len(arguments) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(arguments), len(values))
+	}
+
+	coerced := make([]interface{}, len(values))
+	for i, argument := range arguments {
+		value, err := coerceValue(argument.Type, values[i])
+		if err != nil {
+			name := argument.Name
+			if name == "" {
+				name = strconv.Itoa(i)
+			}
+			return nil, fmt.Errorf("argument %s: %w", name, err)
+		}
+		coerced[i] = value
+	}
+	return coerced, nil
+}
+
+func coerceValue(t abi.Type, value interface{}) (interface{}, error) {
+	target := t.GetType()
+	if value != nil && reflect.TypeOf(value).AssignableTo(target) {
+		return value, nil
+	}
+
+	switch t.T {
+	case abi.AddressTy:
+		if s, ok := value.(string); ok && common.IsHexAddress(s) {
+			return common.HexToAddress(s), nil
+		}
+
+	case abi.BoolTy:
+		if s, ok := value.(string); ok {
+			return strconv.ParseBool(s)
+		}
+
+	case abi.StringTy:
+		if s, ok := value.(fmt.Stringer); ok {
+			return s.String(), nil
+		}
+
+	case abi.IntTy, abi.UintTy:
+		n, err := coerceInteger(value)
+		if err != nil {
+			return nil, err
+		}
+		return integerOfType(t, n)
+
+	case abi.BytesTy:
+		return coerceBytes(value)
+
+	case abi.FixedBytesTy, abi.FunctionTy:
+		b, err := coerceBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != target.Len() {
+			return nil, fmt.Errorf("%s expects %d bytes, got %d", t, target.Len(), len(b))
+		}
+
+		array := reflect.New(target).Elem()
+		reflect.Copy(array, reflect.ValueOf(b))
+		return array.Interface(), nil
+
+	case abi.SliceTy, abi.ArrayTy:
+		elems, err := coerceList(value)
+		if err != nil {
+			return nil, err
+		}
+
+		var list reflect.Value
+		if t.T == abi.ArrayTy {
+			if len(elems) != t.Size {
+				return nil, fmt.Errorf("%s expects %d elements, got %d", t, t.Size, len(elems))
+			}
+			list = reflect.New(target).Elem()
+		} else {
+			list = reflect.MakeSlice(target, len(elems), len(elems))
+		}
+
+		for i, elem := range elems {
+			coerced, err := coerceValue(*t.Elem, elem)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			list.Index(i).Set(reflect.ValueOf(coerced))
+		}
+		return list.Interface(), nil
+
+	case abi.TupleTy:
+		return coerceTuple(t, value)
+	}
+
+	return nil, fmt.Errorf("cannot use %v (%T) as %s", value, value, t)
+}
+
+// coerceInteger converts value to a big.Int, rejecting fractions.
+func coerceInteger(value interface{}) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		if v != nil {
+			return new(big.Int).Set(v), nil
+		}
+	case big.Int:
+		return new(big.Int).Set(&v), nil
+	case decimal.Decimal:
+		if !v.Equal(v.Truncate(0)) {
+			return nil, fmt.Errorf("%s is not an integer", v)
+		}
+		return v.BigInt(), nil
+	case float32, float64:
+		x := reflect.ValueOf(v).Float()
+		// big.NewFloat panics on NaN
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return nil, fmt.Errorf("%v is not an integer", v)
+		}
+		f := big.NewFloat(x)
+		if !f.IsInt() {
+			return nil, fmt.Errorf("%v is not an integer", v)
+		}
+		n, _ := f.Int(nil)
+		return n, nil
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(rv.Uint()), nil
+	case reflect.String:
+		s := strings.TrimSpace(rv.String())
+		if n, ok := new(big.Int).SetString(s, 0); ok {
+			return n, nil
+		}
+
+		d, err := decimal.NewFromString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %q", s)
+		}
+		return coerceInteger(d)
+	}
+
+	return nil, fmt.Errorf("cannot use %v (%T) as an integer", value, value)
+}
+
+// integerOfType range checks n and returns it as the Go type of t: *big.Int
+// above 64 bits, the sized integer types otherwise.
+func integerOfType(t abi.Type, n *big.Int) (interface{}, error) {
+	if t.T == abi.UintTy {
+		if n.Sign() < 0 || n.BitLen() > t.Size {
+			return nil, fmt.Errorf("%s overflows %s", n, t)
+		}
+	} else {
+		limit := new(big.Int).Lsh(big.NewInt(1), uint(t.Size-1))
+		if n.Cmp(limit) >= 0 || n.Cmp(new(big.Int).Neg(limit)) < 0 {
+			return nil, fmt.Errorf("%s overflows %s", n, t)
+		}
+	}
+
+	target := t.GetType()
+	switch target.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.ValueOf(n.Int64()).Convert(target).Interface(), nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflect.ValueOf(n.Uint64()).Convert(target).Interface(), nil
+	}
+	return n, nil
+}
+
+func coerceBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return hexutil.Decode(v)
+	case common.Hash:
+		return v.Bytes(), nil
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8 {
+		b := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(b), rv)
+		return b, nil
+	}
+
+	return nil, fmt.Errorf("cannot use %v (%T) as bytes", value, value)
+}
+
+// coerceList returns the elements of a slice, an array, a JSON array or a
+// comma separated string.
+func coerceList(value interface{}) ([]interface{}, error) {
+	if s, ok := value.(string); ok {
+		s = strings.TrimSpace(s)
+		if strings.HasPrefix(s, "[") {
+			var elems []interface{}
+			if err := decodeJSON(s, &elems); err != nil {
+				return nil, err
+			}
+			return elems, nil
+		}
+
+		if s == "" {
+			return []interface{}{}, nil
+		}
+
+		parts := strings.Split(s, ",")
+		elems := make([]interface{}, len(parts))
+		for i, part := range parts {
+			elems[i] = strings.TrimSpace(part)
+		}
+		return elems, nil
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, fmt.Errorf("cannot use %v (%T) as a list", value, value)
+	}
+
+	elems := make([]interface{}, rv.Len())
+	for i := range elems {
+		elems[i] = rv.Index(i).Interface()
+	}
+	return elems, nil
+}
+
+// coerceTuple builds the struct abi packs t from, out of a map keyed by
+// component name or a list of components in order.
+func coerceTuple(t abi.Type, value interface{}) (interface{}, error) {
+	if s, ok := value.(string); ok {
+		var parsed interface{}
+		if err := decodeJSON(s, &parsed); err != nil {
+			return nil, err
+		}
+		value = parsed
+	}
+
+	components := make([]interface{}, len(t.TupleElems))
+	if fields, ok := value.(map[string]interface{}); ok {
+		for i, name := range t.TupleRawNames {
+			component, ok := fields[name]
+			if !ok {
+				return nil, fmt.Errorf("missing tuple component %q", name)
+			}
+			components[i] = component
+		}
+	} else {
+		elems, err := coerceList(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(elems) != len(components) {
+			return nil, fmt.Errorf("%s expects %d components, got %d", t, len(components), len(elems))
+		}
+		copy(components, elems)
+	}
+
+	tuple := reflect.New(t.GetType()).Elem()
+	for i, elem := range t.TupleElems {
+		coerced, err := coerceValue(*elem, components[i])
+		if err != nil {
+			return nil, fmt.Errorf("component %s: %w", t.TupleRawNames[i], err)
+		}
+		tuple.Field(i).Set(reflect.ValueOf(coerced))
+	}
+	return tuple.Interface(), nil
+}
+
+// decodeJSON keeps numbers as json.Number so large integers stay exact.
+func decodeJSON(s string, v interface{}) error {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
@@ -0,0 +1,169 @@
+package web3helper
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	pancakeRouter "github.com/nikola43/web3golanghelper/contracts/bindings/ipancakerouter02"
+)
+
+var ErrUnknownMethod = errors.New("calldata does not match any registered method")
+
+// DecodedArgument is a single named input of a decoded call.
+type DecodedArgument struct {
+	Name  string
+	Type  string
+	Value interface{}
+}
+
+// DecodedCall describes a contract call recovered from transaction input.
+type DecodedCall struct {
+	Method    string
+	Signature string
+	Selector  [4]byte
+	Arguments []DecodedArgument
+}
+
+// Arg returns the value of the named argument, or nil if it is not present.
+func (c *DecodedCall) Arg(name string) interface{} {
+	for _, arg := range c.Arguments {
+		if arg.Name == name {
+			return arg.Value
+		}
+	}
+	return nil
+}
+
+// CallDecoder resolves calldata against every ABI registered with it. The
+// PancakeRouter ABI is always registered.
+type CallDecoder struct {
+	mu      sync.RWMutex
+	methods map[[4]byte]abi.Method
+}
+
+func NewCallDecoder() *CallDecoder {
+	decoder := &CallDecoder{
+		methods: make(map[[4]byte]abi.Method),
+	}
+
+	if err := decoder.RegisterABI(pancakeRouter.IPancakeRouter02MetaData.ABI); err != nil {
+		panic(err)
+	}
+
+	return decoder
+}
+
+// RegisterABI adds every method of the given ABI JSON to the decoder. Methods
+// already known by selector are kept.
+func (d *CallDecoder) RegisterABI(abiJSON string) error {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, method := range parsed.Methods {
+		var selector [4]byte
+		copy(selector[:], method.ID)
+		if _, ok := d.methods[selector]; !ok {
+			d.methods[selector] = method
+		}
+	}
+
+	return nil
+}
+
+func (d *CallDecoder) Decode(data []byte) (*DecodedCall, error) {
+	if len(data) < 4 {
+		return nil, ErrUnknownMethod
+	}
+
+	var selector [4]byte
+	copy(selector[:], data[:4])
+
+	d.mu.RLock()
+	method, ok := d.methods[selector]
+	d.mu.RUnlock()
+	if !ok {
+		return nil, ErrUnknownMethod
+	}
+
+	values, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, fmt.Errorf("decode %s: %w", method.Name, err)
+	}
+
+	arguments := make([]DecodedArgument, 0, len(values))
+	for i, input := range method.Inputs {
+		arguments = append(arguments, DecodedArgument{
+			Name:  input.Name,
+			Type:  input.Type.String(),
+			Value: values[i],
+		})
+	}
+
+	return &DecodedCall{
+		Method:    method.Name,
+		Signature: method.Sig,
+		Selector:  selector,
+		Arguments: arguments,
+	}, nil
+}
+
+func (d *CallDecoder) DecodeTransaction(tx *types.Transaction) (*DecodedCall, error) {
+	return d.Decode(tx.Data())
+}
+
+// RouterSwap is the typed view of a PancakeRouter swap call. Amounts that the
+// method does not take are left nil; AmountIn is taken from the tx value for
+// the ETH-in variants.
+type RouterSwap struct {
+	Method       string
+	AmountIn     *big.Int
+	AmountInMax  *big.Int
+	AmountOut    *big.Int
+	AmountOutMin *big.Int
+	Path         []common.Address
+	To           common.Address
+	Deadline     *big.Int
+}
+
+// DecodeRouterSwap decodes a transaction sent to PancakeRouter into a RouterSwap.
+// Non-swap router methods return an error.
+func (d *CallDecoder) DecodeRouterSwap(tx *types.Transaction) (*RouterSwap, error) {
+	call, err := d.DecodeTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(call.Method, "swap") {
+		return nil, fmt.Errorf("%s is not a router swap method", call.Method)
+	}
+
+	swap := &RouterSwap{Method: call.Method}
+	swap.AmountIn, _ = call.Arg("amountIn").(*big.Int)
+	swap.AmountInMax, _ = call.Arg("amountInMax").(*big.Int)
+	swap.AmountOut, _ = call.Arg("amountOut").(*big.Int)
+	swap.AmountOutMin, _ = call.Arg("amountOutMin").(*big.Int)
+	swap.Path, _ = call.Arg("path").([]common.Address)
+	swap.To, _ = call.Arg("to").(common.Address)
+	swap.Deadline, _ = call.Arg("deadline").(*big.Int)
+
+	if swap.AmountIn == nil && strings.HasPrefix(call.Method, "swapExactETH") {
+		swap.AmountIn = tx.Value()
+	}
+	if swap.AmountInMax == nil && call.Method == "swapETHForExactTokens" {
+		swap.AmountInMax = tx.Value()
+	}
+
+	return swap, nil
+}
@@ -0,0 +1,227 @@
+package web3helper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// TypedData is an EIP-712 message with its types, primary type and domain,
+// as sent to eth_signTypedData_v4.
+type TypedData = apitypes.TypedData
+
+// TypedDataDomain is the domain of a TypedData.
+type TypedDataDomain = apitypes.TypedDataDomain
+
+// TypedDataField is a field of a struct type of a TypedData.
+type TypedDataField = apitypes.Type
+
+const eip712DomainType = "EIP712Domain"
+
+// NewTypedDataDomain returns the domain of a contract on chainID. version
+// may be empty.
+func NewTypedDataDomain(name string, version string, chainID uint64, verifyingContract common.Address) TypedDataDomain {
+	return TypedDataDomain{
+		Name:              name,
+		Version:           version,
+		ChainId:           math.NewHexOrDecimal256(int64(chainID)),
+		VerifyingContract: verifyingContract.Hex(),
+	}
+}
+
+// TypedDataDomain returns the domain of a contract on the connected chain.
+func (w *Web3GolangHelper) TypedDataDomain(ctx context.Context, name string, version string, verifyingContract common.Address) (TypedDataDomain, error) {
+	chainID, err := w.cachedChainID(ctx)
+	if err != nil {
+		return TypedDataDomain{}, err
+	}
+	return NewTypedDataDomain(name, version, chainID.Uint64(), verifyingContract), nil
+}
+
+// ParseTypedData decodes the JSON description of a typed message. Numbers
+// keep their precision and the chain ID may be a number or a string.
+func ParseTypedData(data []byte) (*TypedData, error) {
+	var raw map[string]json.RawMessage
+	if err := decodeJSONNumbers(data, &raw); err != nil {
+		return nil, err
+	}
+
+	// wallets send the chain ID as a number, apitypes expects a string
+	if domainJSON, ok := raw["domain"]; ok {
+		var domain map[string]interface{}
+		if err := decodeJSONNumbers(domainJSON, &domain); err != nil {
+			return nil, err
+		}
+		if chainID, ok := domain["chainId"].(json.Number); ok {
+			domain["chainId"] = chainID.String()
+		}
+
+		encoded, err := json.Marshal(domain)
+		if err != nil {
+			return nil, err
+		}
+		raw["domain"] = encoded
+	}
+
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	typedData := new(TypedData)
+	if err := decodeJSONNumbers(encoded, typedData); err != nil {
+		return nil, err
+	}
+	return typedData, nil
+}
+
+func decodeJSONNumbers(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// DomainSeparator returns the hash of the domain of typedData.
+func DomainSeparator(typedData *TypedData) (common.Hash, error) {
+	prepared := prepareTypedData(typedData)
+
+	separator, err := prepared.HashStruct(eip712DomainType, prepared.Domain.Map())
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(separator), nil
+}
+
+// HashTypedData returns the EIP-712 digest of typedData,
+// keccak256("\x19\x01" || domainSeparator || hashStruct(message)).
+func HashTypedData(typedData *TypedData) (common.Hash, error) {
+	if typedData.PrimaryType == "" {
+		return common.Hash{}, fmt.Errorf("typed data has no primary type")
+	}
+
+	separator, err := DomainSeparator(typedData)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	prepared := prepareTypedData(typedData)
+	structHash, err := prepared.HashStruct(prepared.PrimaryType, prepared.Message)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash([]byte("\x19\x01"), separator.Bytes(), structHash), nil
+}
+
+// SignTypedData signs the digest of typedData with pk, V as 27 or 28.
+func SignTypedData(typedData *TypedData, pk string) ([]byte, error) {
+	digest, err := HashTypedData(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return signHash(digest.Bytes(), pk)
+}
+
+// RecoverTypedDataSigner returns the address that signed typedData.
+func RecoverTypedDataSigner(typedData *TypedData, signature []byte) (common.Address, error) {
+	digest, err := HashTypedData(typedData)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return recoverAddress(digest.Bytes(), signature)
+}
+
+// VerifyTypedData reports whether address signed typedData.
+func VerifyTypedData(typedData *TypedData, signature []byte, address common.Address) (bool, error) {
+	signer, err := RecoverTypedDataSigner(typedData, signature)
+	if err != nil {
+		return false, err
+	}
+	return signer == address, nil
+}
+
+// prepareTypedData returns a copy of typedData hashable by apitypes: the
+// EIP712Domain type derived from the domain when missing, and the values
+// of Go callers and of ParseTypedData converted to strings.
+func prepareTypedData(typedData *TypedData) *TypedData {
+	prepared := *typedData
+
+	prepared.Types = make(apitypes.Types, len(typedData.Types)+1)
+	for name, fields := range typedData.Types {
+		prepared.Types[name] = fields
+	}
+	if _, ok := prepared.Types[eip712DomainType]; !ok {
+		prepared.Types[eip712DomainType] = domainFields(typedData.Domain)
+	}
+
+	if message, ok := normalizeTypedValue(typedData.Message).(map[string]interface{}); ok {
+		prepared.Message = message
+	}
+	return &prepared
+}
+
+// domainFields returns the EIP712Domain type of the fields set in domain, in
+// the order of the standard.
+func domainFields(domain TypedDataDomain) []TypedDataField {
+	fields := make([]TypedDataField, 0, 5)
+	if domain.Name != "" {
+		fields = append(fields, TypedDataField{Name: "name", Type: "string"})
+	}
+	if domain.Version != "" {
+		fields = append(fields, TypedDataField{Name: "version", Type: "string"})
+	}
+	if domain.ChainId != nil {
+		fields = append(fields, TypedDataField{Name: "chainId", Type: "uint256"})
+	}
+	if domain.VerifyingContract != "" {
+		fields = append(fields, TypedDataField{Name: "verifyingContract", Type: "address"})
+	}
+	if domain.Salt != "" {
+		fields = append(fields, TypedDataField{Name: "salt", Type: "bytes32"})
+	}
+	return fields
+}
+
+// normalizeTypedValue converts the integers, addresses and hashes of a
+// message to the strings apitypes encodes.
+func normalizeTypedValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, field := range v {
+			normalized[key] = normalizeTypedValue(field)
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, len(v))
+		for i, item := range v {
+			normalized[i] = normalizeTypedValue(item)
+		}
+		return normalized
+	case json.Number:
+		return v.String()
+	case *big.Int:
+		return v.String()
+	case int:
+		return big.NewInt(int64(v)).String()
+	case int64:
+		return big.NewInt(v).String()
+	case uint64:
+		return new(big.Int).SetUint64(v).String()
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	}
+	return value
+}
@@ -0,0 +1,117 @@
+package web3helper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/nikola43/web3golanghelper/genericutils"
+)
+
+type ExplorerScheme string
+
+const (
+	// EtherscanScheme covers etherscan, bscscan, snowtrace and their forks.
+	EtherscanScheme ExplorerScheme = "etherscan"
+	// BlockscoutScheme covers Blockscout instances.
+	BlockscoutScheme ExplorerScheme = "blockscout"
+)
+
+// Explorer builds block explorer links for a network.
+type Explorer struct {
+	BaseUrl string
+	Scheme  ExplorerScheme
+}
+
+// Explorer returns the block explorer of the network, or nil when the network
+// has none configured.
+func (n *EVMNetwork) Explorer() *Explorer {
+	if n.ExplorerUrl == "" {
+		return nil
+	}
+
+	scheme := n.ExplorerScheme
+	if scheme == "" {
+		scheme = EtherscanScheme
+	}
+
+	return &Explorer{
+		BaseUrl: strings.TrimRight(n.ExplorerUrl, "/"),
+		Scheme:  scheme,
+	}
+}
+
+func (e *Explorer) TxUrl(txHash string) string {
+	return e.BaseUrl + "/tx/" + txHash
+}
+
+func (e *Explorer) AddressUrl(address string) string {
+	return e.BaseUrl + "/address/" + address
+}
+
+func (e *Explorer) TokenUrl(tokenAddress string) string {
+	if e.Scheme == BlockscoutScheme {
+		return e.BaseUrl + "/tokens/" + tokenAddress
+	}
+	return e.BaseUrl + "/token/" + tokenAddress
+}
+
+func (e *Explorer) BlockUrl(blockNumber uint64) string {
+	if e.Scheme == BlockscoutScheme {
+		return e.BaseUrl + "/blocks/" + strconv.FormatUint(blockNumber, 10)
+	}
+	return e.BaseUrl + "/block/" + strconv.FormatUint(blockNumber, 10)
+}
+
+// Explorer returns the block explorer of the connected network. Helpers built
+// from plain URLs fall back to the built-in network with the same chain ID.
+func (w *Web3GolangHelper) Explorer() (*Explorer, error) {
+	network, err := w.Network()
+	if err != nil {
+		return nil, err
+	}
+
+	explorer := network.Explorer()
+	if explorer == nil {
+		return nil, fmt.Errorf("network %s has no block explorer configured", network.Name)
+	}
+	return explorer, nil
+}
+
+// TxSentHook is called after a transaction is broadcast. explorerUrl is empty
+// when the network has no block explorer.
+type TxSentHook func(txHash string, explorerUrl string)
+
+// OnTransactionSent registers a hook run after every transaction the helper sends.
+func (w *Web3GolangHelper) OnTransactionSent(hook TxSentHook) {
+	w.txSentHooks = append(w.txSentHooks, hook)
+}
+
+// OpenExplorerInBrowser is a TxSentHook that opens the transaction page in
+// the desktop browser. Failures are ignored so trading never depends on it.
+func OpenExplorerInBrowser(txHash string, explorerUrl string) {
+	if explorerUrl != "" {
+		_ = genericutils.OpenBrowser(explorerUrl)
+	}
+}
+
+// notifyTxSent records the transaction in the metrics and runs the sent hooks.
+func (w *Web3GolangHelper) notifyTxSent(tx *types.Transaction) {
+	w.recordTxSent(tx)
+
+	if len(w.txSentHooks) == 0 {
+		return
+	}
+
+	txHash := tx.Hash().Hex()
+	explorerUrl := ""
+	if explorer, err := w.Explorer(); err == nil {
+		explorerUrl = explorer.TxUrl(txHash)
+	}
+
+	for _, hook := range w.txSentHooks {
+		hook(txHash, explorerUrl)
+	}
+}
@@ -0,0 +1,383 @@
+package web3helper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+type FeePriority int
+
+const (
+	SafeLowFeePriority FeePriority = iota
+	StandardFeePriority
+	FastFeePriority
+	InstantFeePriority
+)
+
+// feePercentiles are the tip percentiles of recent blocks each priority pays.
+var feePercentiles = []float64{10, 50, 75, 95}
+
+var feePriorityNames = []string{"safelow", "standard", "fast", "instant"}
+
+func (p FeePriority) String() string {
+	if p < SafeLowFeePriority || p > InstantFeePriority {
+		return fmt.Sprintf("FeePriority(%d)", int(p))
+	}
+	return feePriorityNames[p]
+}
+
+// ParseFeePriority parses safelow, standard, fast or instant.
+func ParseFeePriority(priority string) (FeePriority, error) {
+	for i, name := range feePriorityNames {
+		if strings.EqualFold(priority, name) {
+			return FeePriority(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown fee priority %q", priority)
+}
+
+// FeeEstimate is what a transaction pays to be included at a priority.
+// Chains without EIP-1559 have no BaseFee and the same value in every field.
+type FeeEstimate struct {
+	Priority FeePriority
+
+	// BaseFee is the base fee of the next block.
+	BaseFee *big.Int
+
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+
+	// GasPrice is the price of a legacy transaction, base fee plus tip.
+	GasPrice *big.Int
+}
+
+// FeeOracle suggests fees from the tips paid in the latest blocks of the
+// connected node, read with eth_feeHistory when the node serves it and from
+// the blocks themselves otherwise. Estimates are cached for TTL and each
+// refresh is smoothed against the previous one.
+type FeeOracle struct {
+	// Blocks is the number of recent blocks sampled.
+	Blocks int
+
+	// TTL is how long estimates are served from cache.
+	TTL time.Duration
+
+	// Smoothing is the weight of a refresh against the previous estimate, 1
+	// disables smoothing.
+	Smoothing float64
+
+	backend   Backend
+	rpcClient *rpc.Client
+
+	mu        sync.Mutex
+	tips      []*big.Int
+	baseFee   *big.Int
+	updatedAt time.Time
+}
+
+// NewFeeOracle returns an oracle sampling backend. rpcClient, which may be
+// nil, serves eth_feeHistory.
+func NewFeeOracle(backend Backend, rpcClient *rpc.Client) *FeeOracle {
+	return &FeeOracle{
+		Blocks:    20,
+		TTL:       10 * time.Second,
+		Smoothing: 0.5,
+		backend:   backend,
+		rpcClient: rpcClient,
+	}
+}
+
+// Estimate returns the fees of priority.
+func (o *FeeOracle) Estimate(ctx context.Context, priority FeePriority) (*FeeEstimate, error) {
+	if priority < SafeLowFeePriority || priority > InstantFeePriority {
+		return nil, fmt.Errorf("unknown fee priority %s", priority)
+	}
+
+	tips, baseFee, err := o.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tip := new(big.Int).Set(tips[priority])
+	estimate := &FeeEstimate{
+		Priority:  priority,
+		GasTipCap: tip,
+		GasFeeCap: tip,
+		GasPrice:  tip,
+	}
+
+	// the fee cap covers the base fee doubling over the next blocks
+	if baseFee != nil {
+		estimate.BaseFee = new(big.Int).Set(baseFee)
+		estimate.GasFeeCap = new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), tip)
+		estimate.GasPrice = new(big.Int).Add(baseFee, tip)
+	}
+	return estimate, nil
+}
+
+// Estimates returns the fees of every priority, from safe low to instant.
+func (o *FeeOracle) Estimates(ctx context.Context) ([]*FeeEstimate, error) {
+	estimates := make([]*FeeEstimate, 0, len(feePercentiles))
+	for priority := SafeLowFeePriority; priority <= InstantFeePriority; priority++ {
+		estimate, err := o.Estimate(ctx, priority)
+		if err != nil {
+			return nil, err
+		}
+		estimates = append(estimates, estimate)
+	}
+	return estimates, nil
+}
+
+// current returns the cached tips and base fee, refreshing them when stale.
+// The node is queried without holding the lock, the cache is never modified
+// in place.
+func (o *FeeOracle) current(ctx context.Context) ([]*big.Int, *big.Int, error) {
+	o.mu.Lock()
+	tips, baseFee, updatedAt := o.tips, o.baseFee, o.updatedAt
+	o.mu.Unlock()
+
+	if tips != nil && time.Since(updatedAt) < o.TTL {
+		return tips, baseFee, nil
+	}
+
+	started := time.Now()
+	tips, baseFee, err := o.fetch(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	// a concurrent refresh finished first
+	if o.updatedAt.After(started) {
+		return o.tips, o.baseFee, nil
+	}
+
+	if o.tips != nil && o.Smoothing > 0 && o.Smoothing < 1 {
+		for i := range tips {
+			tips[i] = smoothFee(o.tips[i], tips[i], o.Smoothing)
+		}
+	}
+
+	// a higher priority never pays less than a lower one
+	for i := 1; i < len(tips); i++ {
+		if tips[i].Cmp(tips[i-1]) < 0 {
+			tips[i] = new(big.Int).Set(tips[i-1])
+		}
+	}
+
+	o.tips = tips
+	o.baseFee = baseFee
+	o.updatedAt = time.Now()
+	return tips, baseFee, nil
+}
+
+// fetch samples the tips of the recent blocks and the next base fee.
+func (o *FeeOracle) fetch(ctx context.Context) ([]*big.Int, *big.Int, error) {
+	head, err := o.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rewards, baseFee, err := o.feeHistory(ctx)
+	if err != nil {
+		rewards, err = o.sampleBlocks(ctx, head)
+		if err != nil {
+			return nil, nil, err
+		}
+		baseFee = head.BaseFee
+	}
+
+	tips, err := o.percentileTips(ctx, rewards, baseFee != nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tips, baseFee, nil
+}
+
+// feeHistory returns the tip percentiles of the recent non empty blocks and
+// the base fee of the next one, nil before EIP-1559.
+func (o *FeeOracle) feeHistory(ctx context.Context) ([][]*big.Int, *big.Int, error) {
+	if o.rpcClient == nil {
+		return nil, nil, fmt.Errorf("eth_feeHistory needs an rpc client")
+	}
+
+	var history struct {
+		Reward       [][]*hexutil.Big `json:"reward"`
+		BaseFee      []*hexutil.Big   `json:"baseFeePerGas"`
+		GasUsedRatio []float64        `json:"gasUsedRatio"`
+	}
+	err := o.rpcClient.CallContext(ctx, &history, "eth_feeHistory", hexutil.Uint(o.Blocks), "latest", feePercentiles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rewards := make([][]*big.Int, 0, len(history.Reward))
+	for i, blockRewards := range history.Reward {
+		empty := i < len(history.GasUsedRatio) && history.GasUsedRatio[i] == 0
+		if empty || len(blockRewards) != len(feePercentiles) {
+			continue
+		}
+
+		tips := make([]*big.Int, len(blockRewards))
+		for j, reward := range blockRewards {
+			tips[j] = reward.ToInt()
+		}
+		rewards = append(rewards, tips)
+	}
+
+	var baseFee *big.Int
+	if len(history.BaseFee) > 0 && history.BaseFee[len(history.BaseFee)-1].ToInt().Sign() > 0 {
+		baseFee = history.BaseFee[len(history.BaseFee)-1].ToInt()
+	}
+	return rewards, baseFee, nil
+}
+
+// sampleBlocks computes the tip percentiles of the recent blocks from their
+// transactions.
+func (o *FeeOracle) sampleBlocks(ctx context.Context, head *types.Header) ([][]*big.Int, error) {
+	rewards := make([][]*big.Int, 0, o.Blocks)
+
+	number := new(big.Int).Set(head.Number)
+	for i := 0; i < o.Blocks && number.Sign() >= 0; i++ {
+		block, err := o.backend.BlockByNumber(ctx, number)
+		if err != nil {
+			return nil, err
+		}
+		number = new(big.Int).Sub(number, big.NewInt(1))
+
+		tips := make([]*big.Int, 0, len(block.Transactions()))
+		for _, tx := range block.Transactions() {
+			if tip, err := tx.EffectiveGasTip(block.BaseFee()); err == nil {
+				tips = append(tips, tip)
+			}
+		}
+		if len(tips) == 0 {
+			continue
+		}
+
+		sort.Slice(tips, func(i, j int) bool {
+			return tips[i].Cmp(tips[j]) < 0
+		})
+
+		blockRewards := make([]*big.Int, len(feePercentiles))
+		for j, percentile := range feePercentiles {
+			blockRewards[j] = tips[int(float64(len(tips)-1)*percentile/100)]
+		}
+		rewards = append(rewards, blockRewards)
+	}
+
+	return rewards, nil
+}
+
+// percentileTips returns the median tip of every percentile across blocks,
+// the node suggestion when the recent blocks are empty.
+func (o *FeeOracle) percentileTips(ctx context.Context, rewards [][]*big.Int, london bool) ([]*big.Int, error) {
+	tips := make([]*big.Int, len(feePercentiles))
+
+	if len(rewards) == 0 {
+		var (
+			suggested *big.Int
+			err       error
+		)
+		if london {
+			suggested, err = o.backend.SuggestGasTipCap(ctx)
+		} else {
+			suggested, err = o.backend.SuggestGasPrice(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range tips {
+			tips[i] = new(big.Int).Set(suggested)
+		}
+		return tips, nil
+	}
+
+	for i := range tips {
+		column := make([]*big.Int, len(rewards))
+		for j, blockRewards := range rewards {
+			column[j] = blockRewards[i]
+		}
+
+		sort.Slice(column, func(a, b int) bool {
+			return column[a].Cmp(column[b]) < 0
+		})
+		tips[i] = new(big.Int).Set(column[len(column)/2])
+	}
+	return tips, nil
+}
+
+// smoothFee moves previous towards latest by weight.
+func smoothFee(previous *big.Int, latest *big.Int, weight float64) *big.Int {
+	delta := new(big.Float).SetInt(new(big.Int).Sub(latest, previous))
+	delta.Mul(delta, big.NewFloat(weight))
+
+	step, _ := delta.Int(nil)
+	return step.Add(step, previous)
+}
+
+// FeeOracle returns the oracle pricing the transactions of the helper.
+func (w *Web3GolangHelper) FeeOracle() *FeeOracle {
+	w.feeOracleMu.Lock()
+	defer w.feeOracleMu.Unlock()
+
+	if w.feeOracle == nil {
+		w.feeOracle = NewFeeOracle(w.selectClient(), w.rpcClient())
+	}
+	return w.feeOracle
+}
+
+// SetFeeOracle replaces the default oracle, e.g. to sample more blocks.
+func (w *Web3GolangHelper) SetFeeOracle(oracle *FeeOracle) {
+	w.feeOracleMu.Lock()
+	defer w.feeOracleMu.Unlock()
+
+	w.feeOracle = oracle
+}
+
+// SetFeePriority sets the priority sends and swaps pay, StandardFeePriority
+// by default.
+func (w *Web3GolangHelper) SetFeePriority(priority FeePriority) {
+	w.feePriority = priority
+}
+
+// SuggestFees returns the fees of the priority set with SetFeePriority.
+func (w *Web3GolangHelper) SuggestFees(ctx context.Context) (*FeeEstimate, error) {
+	return w.FeeOracle().Estimate(ctx, w.feePriority)
+}
+
+// suggestGasPrice returns the legacy gas price of the fee priority.
+func (w *Web3GolangHelper) suggestGasPrice(ctx context.Context) (*big.Int, error) {
+	fees, err := w.SuggestFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return fees.GasPrice, nil
+}
+
+// SuggestGasPrice prices the legacy transactions of bindings with the oracle.
+func (b *sendBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return b.helper.suggestGasPrice(ctx)
+}
+
+// SuggestGasTipCap prices the dynamic fee transactions of bindings with the
+// oracle, which add twice the base fee to the fee cap.
+func (b *sendBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	fees, err := b.helper.SuggestFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return fees.GasTipCap, nil
+}
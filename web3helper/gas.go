@@ -0,0 +1,166 @@
+package web3helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/shopspring/decimal"
+)
+
+var ErrGasCeilingExceeded = errors.New("gas limit exceeds the ceiling")
+
+// GasLimitStrategy sets the gas limit of transactions sent without a custom
+// one. Transfers without data to accounts without code use 21000, everything
+// else the node estimate scaled by Multiplier. A limit above the ceiling of
+// the call is lowered to it, unless the estimate alone exceeds it.
+type GasLimitStrategy struct {
+	// Multiplier leaves a safety margin over the estimate, 1.2 adds 20%.
+	Multiplier float64
+
+	// Ceiling caps every call, 0 for none.
+	Ceiling uint64
+
+	// MethodCeilings caps calls by method signature, e.g.
+	// "swapExactETHForTokens(uint256,address[],address,uint256)".
+	MethodCeilings map[string]uint64
+}
+
+func DefaultGasLimitStrategy() *GasLimitStrategy {
+	return &GasLimitStrategy{
+		Multiplier: 1.2,
+		Ceiling:    10000000,
+	}
+}
+
+// SetGasLimitStrategy replaces DefaultGasLimitStrategy.
+func (w *Web3GolangHelper) SetGasLimitStrategy(strategy *GasLimitStrategy) {
+	w.gasStrategy = strategy
+}
+
+func (w *Web3GolangHelper) gasLimitStrategy() *GasLimitStrategy {
+	if w.gasStrategy == nil {
+		return DefaultGasLimitStrategy()
+	}
+	return w.gasStrategy
+}
+
+// ceiling returns the lowest ceiling applying to calldata.
+func (s *GasLimitStrategy) ceiling(data []byte) uint64 {
+	ceiling := s.Ceiling
+	if len(data) < 4 {
+		return ceiling
+	}
+
+	for signature, methodCeiling := range s.MethodCeilings {
+		selector := crypto.Keccak256([]byte(signature))[:4]
+		if string(selector) == string(data[:4]) && (ceiling == 0 || methodCeiling < ceiling) {
+			ceiling = methodCeiling
+		}
+	}
+	return ceiling
+}
+
+// GasQuote is the gas limit of a transaction and the most it can pay for it.
+type GasQuote struct {
+	GasLimit uint64
+
+	// Estimated is the node estimate before the margin, 0 for transfers.
+	Estimated uint64
+
+	// GasPrice is the legacy gas price or the dynamic fee cap.
+	GasPrice *big.Int
+
+	// MaxFee is GasLimit times GasPrice, in wei and in native units.
+	MaxFee       *big.Int
+	MaxFeeNative decimal.Decimal
+}
+
+// GasLimit returns the gas limit of msg following the strategy. msg needs
+// its real From and Value for the estimate to hold.
+func (w *Web3GolangHelper) GasLimit(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	limit, _, err := w.gasLimit(ctx, msg)
+	return limit, err
+}
+
+func (w *Web3GolangHelper) gasLimit(ctx context.Context, msg ethereum.CallMsg) (uint64, uint64, error) {
+	if len(msg.Data) == 0 && msg.To != nil {
+		code, err := w.selectClient().CodeAt(ctx, *msg.To, nil)
+		if err != nil {
+			return 0, 0, err
+		}
+		if len(code) == 0 {
+			return params.TxGas, 0, nil
+		}
+	}
+
+	estimated, err := w.estimateGas(ctx, msg)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	limit, err := w.gasLimitStrategy().limit(estimated, msg.Data)
+	if err != nil {
+		return 0, 0, err
+	}
+	return limit, estimated, nil
+}
+
+// limit applies the margin and ceilings to the estimate of a call of data.
+func (s *GasLimitStrategy) limit(estimated uint64, data []byte) (uint64, error) {
+	limit := estimated
+	if s.Multiplier > 1 {
+		limit = uint64(math.Ceil(float64(estimated) * s.Multiplier))
+	}
+
+	if ceiling := s.ceiling(data); ceiling > 0 && limit > ceiling {
+		if estimated > ceiling {
+			return 0, fmt.Errorf("%w: estimated %d, ceiling %d", ErrGasCeilingExceeded, estimated, ceiling)
+		}
+		limit = ceiling
+	}
+
+	return limit, nil
+}
+
+// QuoteGas returns the gas limit of msg and its max fee at msg.GasFeeCap or
+// msg.GasPrice, the fee oracle gas price when neither is set.
+func (w *Web3GolangHelper) QuoteGas(ctx context.Context, msg ethereum.CallMsg) (*GasQuote, error) {
+	limit, estimated, err := w.gasLimit(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice := msg.GasFeeCap
+	if gasPrice == nil {
+		gasPrice = msg.GasPrice
+	}
+	if gasPrice == nil {
+		if gasPrice, err = w.suggestGasPrice(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	maxFee := CalcGasCost(limit, gasPrice)
+	return &GasQuote{
+		GasLimit:     limit,
+		Estimated:    estimated,
+		GasPrice:     gasPrice,
+		MaxFee:       maxFee,
+		MaxFeeNative: w.toNative(maxFee),
+	}, nil
+}
+
+// toNative converts wei to the native currency of the connected network.
+func (w *Web3GolangHelper) toNative(wei *big.Int) decimal.Decimal {
+	decimals := 18
+	if network, err := w.Network(); err == nil && network.NativeCurrency.Decimals > 0 {
+		decimals = int(network.NativeCurrency.Decimals)
+	}
+	return ToDecimal(wei, decimals)
+}
@@ -0,0 +1,213 @@
+package web3helper
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LogLevel is the severity of a log entry. The values match log/slog.
+type LogLevel int
+
+const (
+	DebugLogLevel LogLevel = -4
+	InfoLogLevel  LogLevel = 0
+	WarnLogLevel  LogLevel = 4
+	ErrorLogLevel LogLevel = 8
+)
+
+func (l LogLevel) String() string {
+	switch l {
+	case DebugLogLevel:
+		return "DEBUG"
+	case InfoLogLevel:
+		return "INFO"
+	case WarnLogLevel:
+		return "WARN"
+	case ErrorLogLevel:
+		return "ERROR"
+	}
+	return fmt.Sprintf("LEVEL(%d)", int(l))
+}
+
+// ParseLogLevel parses debug, info, warn or error.
+func ParseLogLevel(level string) (LogLevel, error) {
+	switch strings.ToLower(level) {
+	case "debug":
+		return DebugLogLevel, nil
+	case "info":
+		return InfoLogLevel, nil
+	case "warn", "warning":
+		return WarnLogLevel, nil
+	case "error":
+		return ErrorLogLevel, nil
+	}
+	return 0, fmt.Errorf("unknown log level %q", level)
+}
+
+// Logger receives the log entries of the helper. keyvals alternate keys and
+// values, like the arguments of slog.Logger.Log.
+type Logger interface {
+	Log(level LogLevel, msg string, keyvals ...interface{})
+}
+
+// LoggerFunc adapts a function to Logger.
+type LoggerFunc func(level LogLevel, msg string, keyvals ...interface{})
+
+func (f LoggerFunc) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	f(level, msg, keyvals...)
+}
+
+type textLogger struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// NewTextLogger returns a logger writing "time level msg key=value" lines to out.
+func NewTextLogger(out io.Writer) Logger {
+	return &textLogger{out: out}
+}
+
+func (l *textLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	line := &strings.Builder{}
+	fmt.Fprintf(line, "%s %s %s", time.Now().Format(time.RFC3339), level, msg)
+
+	for i := 0; i < len(keyvals); i += 2 {
+		if i+1 < len(keyvals) {
+			fmt.Fprintf(line, " %v=%v", keyvals[i], keyvals[i+1])
+		} else {
+			fmt.Fprintf(line, " !BADKEY=%v", keyvals[i])
+		}
+	}
+	line.WriteByte('\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = io.WriteString(l.out, line.String())
+}
+
+// SetLogger sets the logger of the helper. Nothing is logged by default.
+func (w *Web3GolangHelper) SetLogger(logger Logger) {
+	w.logger = logger
+}
+
+// SetLogLevel drops entries below level, InfoLogLevel by default.
+func (w *Web3GolangHelper) SetLogLevel(level LogLevel) {
+	w.logLevel = level
+}
+
+// log adds the chain ID once known and redacts secrets before handing the
+// entry to the logger.
+func (w *Web3GolangHelper) log(level LogLevel, msg string, keyvals ...interface{}) {
+	if w.logger == nil || level < w.logLevel {
+		return
+	}
+
+	w.chainIDMu.Lock()
+	if w.chainID != nil {
+		keyvals = append([]interface{}{"chain", w.chainID.String()}, keyvals...)
+	}
+	w.chainIDMu.Unlock()
+
+	w.logger.Log(level, msg, Redact(keyvals)...)
+}
+
+const redacted = "[REDACTED]"
+
+var secretKeys = []string{"privatekey", "private_key", "secret", "mnemonic", "password", "passphrase"}
+
+// maxRedactDepth bounds the inspection of nested values.
+const maxRedactDepth = 8
+
+// Redact returns a copy of keyvals with private keys and other secrets
+// replaced, matched by key name or by the *ecdsa.PrivateKey type. Hex strings
+// are not inspected, a 32 byte key cannot be told from a hash. Maps, slices and
+// structs holding a secret are replaced as a whole.
+func Redact(keyvals []interface{}) []interface{} {
+	redactedKeyvals := make([]interface{}, len(keyvals))
+	copy(redactedKeyvals, keyvals)
+
+	for i := 1; i < len(redactedKeyvals); i += 2 {
+		if isSecretKey(fmt.Sprint(redactedKeyvals[i-1])) || isSecretValue(redactedKeyvals[i]) {
+			redactedKeyvals[i] = redacted
+		}
+	}
+	return redactedKeyvals
+}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	if key == "pk" {
+		return true
+	}
+
+	for _, secretKey := range secretKeys {
+		if strings.Contains(key, secretKey) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSecretValue(value interface{}) bool {
+	return containsSecret(reflect.ValueOf(value), 0)
+}
+
+// containsSecret reports whether v is a private key, or holds one in its
+// elements, map entries or exported fields, or holds any value under a secret
+// key or field name.
+func containsSecret(v reflect.Value, depth int) bool {
+	if !v.IsValid() || depth > maxRedactDepth {
+		return false
+	}
+
+	if v.CanInterface() {
+		switch v.Interface().(type) {
+		case *ecdsa.PrivateKey, ecdsa.PrivateKey:
+			return true
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return !v.IsNil() && containsSecret(v.Elem(), depth+1)
+	case reflect.Slice, reflect.Array:
+		// bytes, hashes and addresses
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return false
+		}
+		for i := 0; i < v.Len(); i++ {
+			if containsSecret(v.Index(i), depth+1) {
+				return true
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if isSecretKey(fmt.Sprint(iter.Key())) && !iter.Value().IsZero() {
+				return true
+			}
+			if containsSecret(iter.Value(), depth+1) {
+				return true
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			if isSecretKey(field.Name) && !v.Field(i).IsZero() {
+				return true
+			}
+			if containsSecret(v.Field(i), depth+1) {
+				return true
+			}
+		}
+	}
+	return false
+}
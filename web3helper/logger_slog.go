@@ -0,0 +1,25 @@
+//go:build go1.21
+
+package web3helper
+
+import (
+	"context"
+	"log/slog"
+)
+
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger adapts logger to Logger, slog.Default() when nil. Levels are
+// passed through unchanged, so the handler level applies as well.
+func NewSlogLogger(logger *slog.Logger) Logger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &slogLogger{logger: logger}
+}
+
+func (l *slogLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	l.logger.Log(context.Background(), slog.Level(level), msg, keyvals...)
+}
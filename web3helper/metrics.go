@@ -0,0 +1,481 @@
+package web3helper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Metric names recorded by the helper.
+const (
+	RpcRequestsMetric            = "web3helper_rpc_requests_total"
+	RpcErrorsMetric              = "web3helper_rpc_errors_total"
+	RpcDurationMetric            = "web3helper_rpc_duration_seconds"
+	TxSentMetric                 = "web3helper_transactions_sent_total"
+	TxConfirmedMetric            = "web3helper_transactions_confirmed_total"
+	TxFailedMetric               = "web3helper_transactions_failed_total"
+	TxReplacedMetric             = "web3helper_transactions_replaced_total"
+	GasUsedMetric                = "web3helper_gas_used_total"
+	GasFeesMetric                = "web3helper_gas_fees_wei_total"
+	NonceGapMetric               = "web3helper_nonce_gap"
+	SubscriptionsMetric          = "web3helper_subscriptions_total"
+	SubscriptionErrorsMetric     = "web3helper_subscription_errors_total"
+	SubscriptionReconnectsMetric = "web3helper_subscription_reconnects_total"
+	EventLagMetric               = "web3helper_event_lag_seconds"
+)
+
+type Labels map[string]string
+
+const (
+	// maxTrackedTxs and trackedTxTTL bound the sent transactions awaiting a
+	// receipt for the confirmed and failed counters. Older ones are no
+	// longer counted.
+	maxTrackedTxs = 1024
+	trackedTxTTL  = time.Hour
+
+	// receiptPollInterval is how often receipts of sent transactions are
+	// checked on networks without an average block time.
+	receiptPollInterval = 5 * time.Second
+)
+
+// MetricsSink receives the metrics of the helper. Counters are incremented by
+// delta, gauges set to value and histograms observe value.
+type MetricsSink interface {
+	AddCounter(name string, labels Labels, delta float64)
+	SetGauge(name string, labels Labels, value float64)
+	Observe(name string, labels Labels, value float64)
+}
+
+// SetMetricsSink enables metrics. RPC calls are only measured on the HTTP and
+// websocket endpoints dialed by the helper constructors, not on clients added
+// by the caller. Receipts of sent transactions are polled in the background
+// for up to an hour to count them as confirmed or failed.
+func (w *Web3GolangHelper) SetMetricsSink(sink MetricsSink) {
+	if w.metrics == nil {
+		w.metrics = &metricsRecorder{}
+	}
+	w.metrics.setSink(sink)
+}
+
+// metricsRecorder is shared by the helper and its rpc transports, which are
+// created before the helper exists.
+type metricsRecorder struct {
+	sink atomic.Value // sinkHolder
+
+	mu                  sync.Mutex
+	sentTxs             map[common.Hash]trackedTx
+	watchingReceipts    bool
+	failedSubscriptions map[string]bool
+}
+
+type trackedTx struct {
+	tx     *types.Transaction
+	sentAt time.Time
+}
+
+// trackTx adds tx to the transactions awaiting a receipt, evicting expired
+// ones and the oldest above maxTrackedTxs. It reports whether the receipt
+// watcher must be started. Callers hold mu.
+func (m *metricsRecorder) trackTx(tx *types.Transaction, now time.Time) bool {
+	if m.sentTxs == nil {
+		m.sentTxs = make(map[common.Hash]trackedTx)
+	}
+	m.evictTxs(now)
+
+	for len(m.sentTxs) >= maxTrackedTxs {
+		var oldest common.Hash
+		var oldestAt time.Time
+		for hash, tracked := range m.sentTxs {
+			if oldestAt.IsZero() || tracked.sentAt.Before(oldestAt) {
+				oldest, oldestAt = hash, tracked.sentAt
+			}
+		}
+		delete(m.sentTxs, oldest)
+	}
+	m.sentTxs[tx.Hash()] = trackedTx{tx: tx, sentAt: now}
+
+	start := !m.watchingReceipts
+	m.watchingReceipts = true
+	return start
+}
+
+// evictTxs stops tracking the transactions sent more than trackedTxTTL ago.
+// Callers hold mu.
+func (m *metricsRecorder) evictTxs(now time.Time) {
+	for hash, tracked := range m.sentTxs {
+		if now.Sub(tracked.sentAt) > trackedTxTTL {
+			delete(m.sentTxs, hash)
+		}
+	}
+}
+
+type sinkHolder struct {
+	sink MetricsSink
+}
+
+func (m *metricsRecorder) setSink(sink MetricsSink) {
+	m.sink.Store(sinkHolder{sink: sink})
+}
+
+// current returns the sink, nil when metrics are disabled.
+func (m *metricsRecorder) current() MetricsSink {
+	if m == nil {
+		return nil
+	}
+	holder, _ := m.sink.Load().(sinkHolder)
+	return holder.sink
+}
+
+func (w *Web3GolangHelper) chainLabel() string {
+	w.chainIDMu.Lock()
+	defer w.chainIDMu.Unlock()
+
+	if w.chainID == nil {
+		return ""
+	}
+	return w.chainID.String()
+}
+
+// recordNonceGap reports how far nonce is ahead of the pending nonce of from.
+// A gap keeps the transaction, and every later one, out of blocks.
+func (w *Web3GolangHelper) recordNonceGap(ctx context.Context, from common.Address, nonce uint64) {
+	sink := w.metrics.current()
+	if sink == nil {
+		return
+	}
+
+	pendingNonce, err := w.selectClient().PendingNonceAt(ctx, from)
+	if err != nil {
+		return
+	}
+
+	gap := float64(0)
+	if nonce > pendingNonce {
+		gap = float64(nonce - pendingNonce)
+	}
+	sink.SetGauge(NonceGapMetric, Labels{"chain": w.chainLabel(), "from": from.Hex()}, gap)
+}
+
+func (w *Web3GolangHelper) recordTxSent(tx *types.Transaction) {
+	sink := w.metrics.current()
+	if sink == nil {
+		return
+	}
+
+	w.metrics.mu.Lock()
+	start := w.metrics.trackTx(tx, time.Now())
+	w.metrics.mu.Unlock()
+
+	if start {
+		go w.watchReceipts()
+	}
+
+	sink.AddCounter(TxSentMetric, Labels{"chain": w.chainLabel()}, 1)
+}
+
+// watchReceipts polls the receipts of the tracked transactions, so sends
+// nobody waits for are counted too. It returns once none is left.
+func (w *Web3GolangHelper) watchReceipts() {
+	interval := receiptPollInterval
+	if w.network != nil && w.network.AverageBlockTime > 0 {
+		interval = time.Duration(w.network.AverageBlockTime)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		w.metrics.mu.Lock()
+		w.metrics.evictTxs(time.Now())
+		hashes := make([]common.Hash, 0, len(w.metrics.sentTxs))
+		for hash := range w.metrics.sentTxs {
+			hashes = append(hashes, hash)
+		}
+		if len(hashes) == 0 {
+			w.metrics.watchingReceipts = false
+		}
+		w.metrics.mu.Unlock()
+
+		if len(hashes) == 0 {
+			return
+		}
+
+		for _, hash := range hashes {
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+			receipt, err := w.selectClient().TransactionReceipt(ctx, hash)
+			if err == nil && receipt != nil {
+				w.recordReceipt(ctx, receipt)
+			}
+			cancel()
+		}
+	}
+}
+
+func (w *Web3GolangHelper) recordTxRejected() {
+	if sink := w.metrics.current(); sink != nil {
+		sink.AddCounter(TxFailedMetric, Labels{"chain": w.chainLabel(), "reason": "rejected"}, 1)
+	}
+}
+
+// recordTxReplaced stops tracking the replaced transaction, its replacement is
+// tracked when sent.
+func (w *Web3GolangHelper) recordTxReplaced(replaced common.Hash) {
+	sink := w.metrics.current()
+	if sink == nil {
+		return
+	}
+
+	w.metrics.mu.Lock()
+	delete(w.metrics.sentTxs, replaced)
+	w.metrics.mu.Unlock()
+
+	sink.AddCounter(TxReplacedMetric, Labels{"chain": w.chainLabel()}, 1)
+}
+
+// recordReceipt counts the outcome and gas of a transaction sent by the
+// helper the first time its receipt is seen.
+func (w *Web3GolangHelper) recordReceipt(ctx context.Context, receipt *types.Receipt) {
+	sink := w.metrics.current()
+	if sink == nil || receipt == nil {
+		return
+	}
+
+	w.metrics.mu.Lock()
+	tracked, ok := w.metrics.sentTxs[receipt.TxHash]
+	delete(w.metrics.sentTxs, receipt.TxHash)
+	w.metrics.mu.Unlock()
+
+	if !ok {
+		return
+	}
+	tx := tracked.tx
+
+	chain := w.chainLabel()
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		sink.AddCounter(TxConfirmedMetric, Labels{"chain": chain}, 1)
+	} else {
+		sink.AddCounter(TxFailedMetric, Labels{"chain": chain, "reason": "reverted"}, 1)
+	}
+
+	sink.AddCounter(GasUsedMetric, Labels{"chain": chain}, float64(receipt.GasUsed))
+
+	gasPrice := tx.GasPrice()
+	if tx.Type() == types.DynamicFeeTxType {
+		header, err := w.selectClient().HeaderByHash(ctx, receipt.BlockHash)
+		if err != nil || header.BaseFee == nil {
+			return
+		}
+		gasPrice = effectiveGasPrice(tx, header.BaseFee)
+	}
+
+	fee, _ := new(big.Float).SetInt(new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(receipt.GasUsed))).Float64()
+	sink.AddCounter(GasFeesMetric, Labels{"chain": chain}, fee)
+}
+
+// effectiveGasPrice is the price paid per gas by a dynamic fee transaction.
+func effectiveGasPrice(tx *types.Transaction, baseFee *big.Int) *big.Int {
+	price := new(big.Int).Add(baseFee, tx.GasTipCap())
+	if price.Cmp(tx.GasFeeCap()) > 0 {
+		return new(big.Int).Set(tx.GasFeeCap())
+	}
+	return price
+}
+
+// recordSubscription counts a new subscription of kind, as a reconnect when
+// the previous one of the same kind failed.
+func (w *Web3GolangHelper) recordSubscription(kind string) {
+	sink := w.metrics.current()
+	if sink == nil {
+		return
+	}
+
+	labels := Labels{"chain": w.chainLabel(), "subscription": kind}
+
+	w.metrics.mu.Lock()
+	reconnect := w.metrics.failedSubscriptions[kind]
+	delete(w.metrics.failedSubscriptions, kind)
+	w.metrics.mu.Unlock()
+
+	sink.AddCounter(SubscriptionsMetric, labels, 1)
+	if reconnect {
+		sink.AddCounter(SubscriptionReconnectsMetric, labels, 1)
+	}
+}
+
+func (w *Web3GolangHelper) recordSubscriptionError(kind string) {
+	sink := w.metrics.current()
+	if sink == nil {
+		return
+	}
+
+	w.metrics.mu.Lock()
+	if w.metrics.failedSubscriptions == nil {
+		w.metrics.failedSubscriptions = make(map[string]bool)
+	}
+	w.metrics.failedSubscriptions[kind] = true
+	w.metrics.mu.Unlock()
+
+	w.countSubscriptionError(kind)
+}
+
+// countSubscriptionError counts an event a subscription skipped, without
+// marking the subscription as failed.
+func (w *Web3GolangHelper) countSubscriptionError(kind string) {
+	if sink := w.metrics.current(); sink != nil {
+		sink.AddCounter(SubscriptionErrorsMetric, Labels{"chain": w.chainLabel(), "subscription": kind}, 1)
+	}
+}
+
+// recordEventLag observes the delay between the block timestamp of an event
+// and its delivery.
+func (w *Web3GolangHelper) recordEventLag(kind string, blockTime uint64) {
+	if sink := w.metrics.current(); sink != nil {
+		lag := time.Since(time.Unix(int64(blockTime), 0)).Seconds()
+		sink.Observe(EventLagMetric, Labels{"chain": w.chainLabel(), "subscription": kind}, lag)
+	}
+}
+
+// dialMeteredHttpClient dials rpcUrl through a transport reporting every
+// JSON-RPC call to metrics.
+func dialMeteredHttpClient(rpcUrl string, metrics *metricsRecorder) (*rpc.Client, error) {
+
+	rpcClient, err := newMeteredHttpClient(rpcUrl, metrics)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := ethclient.NewClient(rpcClient).BlockNumber(context.Background()); err != nil {
+		rpcClient.Close()
+		return nil, err
+	}
+
+	return rpcClient, nil
+}
+
+// newMeteredHttpClient is dialMeteredHttpClient without the connectivity
+// check.
+func newMeteredHttpClient(rpcUrl string, metrics *metricsRecorder) (*rpc.Client, error) {
+	return rpc.DialHTTPWithClient(rpcUrl, &http.Client{
+		Transport: &metricsTransport{
+			endpoint: endpointLabel(rpcUrl),
+			metrics:  metrics,
+			next:     http.DefaultTransport,
+		},
+	})
+}
+
+// endpointLabel keeps only the host of rpcUrl, paths and queries often carry
+// api keys.
+func endpointLabel(rpcUrl string) string {
+	u, err := url.Parse(rpcUrl)
+	if err != nil || u.Host == "" {
+		return "unknown"
+	}
+	return u.Host
+}
+
+type metricsTransport struct {
+	endpoint string
+	metrics  *metricsRecorder
+	next     http.RoundTripper
+}
+
+type jsonRpcMessage struct {
+	Method string          `json:"method"`
+	Error  json.RawMessage `json:"error"`
+}
+
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	sink := t.metrics.current()
+	if sink == nil || req.Body == nil {
+		return t.next.RoundTrip(req)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	methods := jsonRpcMethods(body)
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	duration := time.Since(start).Seconds()
+
+	failed := err != nil
+	if err == nil {
+		if resp.StatusCode != http.StatusOK {
+			failed = true
+		} else {
+			respBody, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if readErr != nil {
+				return nil, readErr
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(respBody))
+			failed = jsonRpcFailed(respBody)
+		}
+	}
+
+	for _, method := range methods {
+		labels := Labels{"endpoint": t.endpoint, "method": method}
+		sink.AddCounter(RpcRequestsMetric, labels, 1)
+		sink.Observe(RpcDurationMetric, labels, duration)
+		if failed {
+			sink.AddCounter(RpcErrorsMetric, labels, 1)
+		}
+	}
+
+	return resp, err
+}
+
+// jsonRpcMethods returns the methods of a single or batch request.
+func jsonRpcMethods(body []byte) []string {
+	var batch []jsonRpcMessage
+	if err := json.Unmarshal(body, &batch); err != nil {
+		var single jsonRpcMessage
+		if err := json.Unmarshal(body, &single); err != nil {
+			return []string{"unknown"}
+		}
+		batch = []jsonRpcMessage{single}
+	}
+
+	methods := make([]string, 0, len(batch))
+	for _, message := range batch {
+		methods = append(methods, message.Method)
+	}
+	return methods
+}
+
+// jsonRpcFailed reports whether any response of body carries an error.
+func jsonRpcFailed(body []byte) bool {
+	var batch []jsonRpcMessage
+	if err := json.Unmarshal(body, &batch); err != nil {
+		var single jsonRpcMessage
+		if err := json.Unmarshal(body, &single); err != nil {
+			return true
+		}
+		batch = []jsonRpcMessage{single}
+	}
+
+	for _, message := range batch {
+		if len(message.Error) > 0 && string(message.Error) != "null" {
+			return true
+		}
+	}
+	return false
+}
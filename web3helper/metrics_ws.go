@@ -0,0 +1,411 @@
+package web3helper
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+)
+
+// maxMeteredMessage bounds the websocket messages decoded for metrics, larger
+// ones pass through unmetered.
+const maxMeteredMessage = 16 * 1024 * 1024
+
+// dialMeteredWsClient dials a websocket endpoint through a connection
+// reporting every JSON-RPC call to metrics, like the transport of HTTP
+// endpoints. Proxies and TLS follow http.DefaultTransport, as for HTTP
+// endpoints.
+func dialMeteredWsClient(ctx context.Context, rpcUrl string, metrics *metricsRecorder) (*rpc.Client, error) {
+	endpoint := endpointLabel(rpcUrl)
+
+	proxy := http.ProxyFromEnvironment
+	var tlsConfig *tls.Config
+	if transport, ok := http.DefaultTransport.(*http.Transport); ok {
+		proxy = transport.Proxy
+		tlsConfig = transport.TLSClientConfig
+	}
+
+	// The connections are dialed here so the metered connection sees plain
+	// frames. Dialer.Proxy stays nil: the dialer would reach the proxy through
+	// NetDialTLSContext and negotiate TLS with the proxy itself.
+	dialer := websocket.Dialer{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		NetDialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			conn, err := dialThroughProxy(ctx, proxy, "http", network, addr)
+			if err != nil {
+				return nil, err
+			}
+			return newMetricsConn(conn, endpoint, metrics), nil
+		},
+		NetDialTLSContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			conn, err := dialThroughProxy(ctx, proxy, "https", network, addr)
+			if err != nil {
+				return nil, err
+			}
+
+			config := &tls.Config{}
+			if tlsConfig != nil {
+				config = tlsConfig.Clone()
+			}
+			if config.ServerName == "" {
+				host, _, err := net.SplitHostPort(addr)
+				if err != nil {
+					host = addr
+				}
+				config.ServerName = host
+			}
+
+			tlsConn := tls.Client(conn, config)
+			if err := tlsConn.HandshakeContext(ctx); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			return newMetricsConn(tlsConn, endpoint, metrics), nil
+		},
+	}
+
+	return rpc.DialWebsocketWithDialer(ctx, rpcUrl, "", dialer)
+}
+
+// dialThroughProxy connects to addr, tunneling through the HTTP proxy proxy
+// returns for it. scheme is the one of addr after the upgrade: "http" or
+// "https".
+func dialThroughProxy(ctx context.Context, proxy func(*http.Request) (*url.URL, error), scheme, network, addr string) (net.Conn, error) {
+	var proxyUrl *url.URL
+	if proxy != nil {
+		var err error
+		proxyUrl, err = proxy(&http.Request{URL: &url.URL{Scheme: scheme, Host: addr}})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if proxyUrl == nil {
+		return new(net.Dialer).DialContext(ctx, network, addr)
+	}
+
+	if proxyUrl.Scheme != "http" {
+		return nil, fmt.Errorf("unsupported proxy scheme %q", proxyUrl.Scheme)
+	}
+	proxyAddr := proxyUrl.Host
+	if proxyUrl.Port() == "" {
+		proxyAddr = net.JoinHostPort(proxyUrl.Hostname(), "80")
+	}
+
+	conn, err := new(net.Dialer).DialContext(ctx, network, proxyAddr)
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	connect := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if user := proxyUrl.User; user != nil {
+		password, _ := user.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		connect.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+	if err := connect.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	// the server speaks only after the tunnel is used, nothing is buffered
+	// past the response
+	resp, err := http.ReadResponse(bufio.NewReader(conn), connect)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy %s: %s", proxyUrl.Host, resp.Status)
+	}
+	return conn, nil
+}
+
+// isWebsocketUrl reports whether rpcUrl can be dialed by dialMeteredWsClient.
+func isWebsocketUrl(rpcUrl string) bool {
+	u, err := url.Parse(rpcUrl)
+	return err == nil && (strings.EqualFold(u.Scheme, "ws") || strings.EqualFold(u.Scheme, "wss"))
+}
+
+// metricsConn decodes the websocket frames of a connection to time each
+// JSON-RPC request until its response.
+type metricsConn struct {
+	net.Conn
+
+	endpoint string
+	metrics  *metricsRecorder
+
+	out *frameReader
+	in  *frameReader
+
+	mu      sync.Mutex
+	pending map[string]pendingCall
+}
+
+type pendingCall struct {
+	method string
+	start  time.Time
+}
+
+type jsonRpcCall struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Error  json.RawMessage `json:"error"`
+}
+
+func newMetricsConn(conn net.Conn, endpoint string, metrics *metricsRecorder) *metricsConn {
+	return &metricsConn{
+		Conn:     conn,
+		endpoint: endpoint,
+		metrics:  metrics,
+		out:      &frameReader{},
+		in:       &frameReader{},
+		pending:  make(map[string]pendingCall),
+	}
+}
+
+func (c *metricsConn) Write(p []byte) (int, error) {
+	n, err := c.Conn.Write(p)
+	if n > 0 {
+		for _, message := range c.out.feed(p[:n]) {
+			c.requestSent(message)
+		}
+	}
+	return n, err
+}
+
+func (c *metricsConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	if n > 0 {
+		for _, message := range c.in.feed(p[:n]) {
+			c.responseReceived(message)
+		}
+	}
+	return n, err
+}
+
+func (c *metricsConn) requestSent(message []byte) {
+	// nothing to time until a sink is set
+	if c.metrics.current() == nil {
+		return
+	}
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, call := range decodeJsonRpcCalls(message) {
+		if call.Method != "" && len(call.ID) > 0 {
+			c.pending[string(call.ID)] = pendingCall{method: call.Method, start: now}
+		}
+	}
+}
+
+func (c *metricsConn) responseReceived(message []byte) {
+	sink := c.metrics.current()
+
+	for _, call := range decodeJsonRpcCalls(message) {
+		// subscription notifications carry a method and no id
+		if call.Method != "" || len(call.ID) == 0 {
+			continue
+		}
+
+		c.mu.Lock()
+		request, ok := c.pending[string(call.ID)]
+		delete(c.pending, string(call.ID))
+		c.mu.Unlock()
+
+		if !ok || sink == nil {
+			continue
+		}
+
+		labels := Labels{"endpoint": c.endpoint, "method": request.method}
+		sink.AddCounter(RpcRequestsMetric, labels, 1)
+		sink.Observe(RpcDurationMetric, labels, time.Since(request.start).Seconds())
+		if len(call.Error) > 0 && string(call.Error) != "null" {
+			sink.AddCounter(RpcErrorsMetric, labels, 1)
+		}
+	}
+}
+
+// decodeJsonRpcCalls returns the messages of a single or batch message.
+func decodeJsonRpcCalls(message []byte) []jsonRpcCall {
+	message = bytes.TrimSpace(message)
+
+	var calls []jsonRpcCall
+	if bytes.HasPrefix(message, []byte("[")) {
+		if err := json.Unmarshal(message, &calls); err != nil {
+			return nil
+		}
+		return calls
+	}
+
+	var call jsonRpcCall
+	if err := json.Unmarshal(message, &call); err != nil {
+		return nil
+	}
+	return []jsonRpcCall{call}
+}
+
+// frameReader reassembles the data messages of one direction of a websocket
+// connection, after skipping the HTTP upgrade.
+type frameReader struct {
+	upgraded bool
+	buf      []byte
+
+	// discard is the rest of a payload too large to meter
+	discard uint64
+
+	message  []byte
+	skipping bool
+}
+
+// feed consumes data and returns the messages it completes.
+func (r *frameReader) feed(data []byte) [][]byte {
+	r.buf = append(r.buf, data...)
+
+	if !r.upgraded {
+		end := bytes.Index(r.buf, []byte("\r\n\r\n"))
+		if end < 0 {
+			return nil
+		}
+		r.buf = r.buf[end+4:]
+		r.upgraded = true
+	}
+
+	var messages [][]byte
+	for {
+		if r.discard > 0 {
+			n := r.discard
+			if n > uint64(len(r.buf)) {
+				n = uint64(len(r.buf))
+			}
+			r.buf = r.buf[n:]
+			r.discard -= n
+			if r.discard > 0 {
+				break
+			}
+		}
+
+		header, ok := parseFrameHeader(r.buf)
+		if !ok {
+			break
+		}
+
+		// control frames may be interleaved with fragments
+		control := header.opcode >= websocket.CloseMessage
+
+		if header.length > maxMeteredMessage-uint64(len(r.message)) {
+			r.buf = r.buf[header.size:]
+			r.discard = header.length
+			if !control {
+				r.skipping = true
+				r.message = nil
+			}
+		} else {
+			end := header.size + int(header.length)
+			if len(r.buf) < end {
+				break
+			}
+			payload := r.buf[header.size:end]
+			r.buf = r.buf[end:]
+
+			if !control && !r.skipping {
+				start := len(r.message)
+				r.message = append(r.message, payload...)
+				if header.masked {
+					for i := range payload {
+						r.message[start+i] ^= header.mask[i%4]
+					}
+				}
+			}
+		}
+
+		if !control && header.fin {
+			if !r.skipping {
+				messages = append(messages, r.message)
+			}
+			r.message = nil
+			r.skipping = false
+		}
+	}
+
+	if len(r.buf) == 0 {
+		r.buf = nil
+	}
+	return messages
+}
+
+type frameHeader struct {
+	fin    bool
+	opcode int
+	masked bool
+	mask   [4]byte
+	length uint64
+
+	// size is the length of the header
+	size int
+}
+
+// parseFrameHeader decodes the header at the start of buf, ok is false when
+// buf does not hold all of it.
+func parseFrameHeader(buf []byte) (frameHeader, bool) {
+	if len(buf) < 2 {
+		return frameHeader{}, false
+	}
+
+	header := frameHeader{
+		fin:    buf[0]&0x80 != 0,
+		opcode: int(buf[0] & 0x0f),
+		masked: buf[1]&0x80 != 0,
+		length: uint64(buf[1] & 0x7f),
+		size:   2,
+	}
+
+	switch header.length {
+	case 126:
+		if len(buf) < 4 {
+			return frameHeader{}, false
+		}
+		header.length = uint64(binary.BigEndian.Uint16(buf[2:4]))
+		header.size = 4
+	case 127:
+		if len(buf) < 10 {
+			return frameHeader{}, false
+		}
+		header.length = binary.BigEndian.Uint64(buf[2:10])
+		header.size = 10
+	}
+
+	if header.masked {
+		if len(buf) < header.size+4 {
+			return frameHeader{}, false
+		}
+		copy(header.mask[:], buf[header.size:header.size+4])
+		header.size += 4
+	}
+	return header, true
+}
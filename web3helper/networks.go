@@ -1,31 +1,167 @@
 package web3helper
 
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type NativeCurrency struct {
+	Symbol   string `json:"symbol" yaml:"symbol"`
+	Decimals uint8  `json:"decimals" yaml:"decimals"`
+}
+
+// DexConfig holds the addresses of a Uniswap V2 style exchange.
+type DexConfig struct {
+	Name    string         `json:"name" yaml:"name"`
+	Router  common.Address `json:"router" yaml:"router"`
+	Factory common.Address `json:"factory" yaml:"factory"`
+}
+
+// Duration is a time.Duration that reads and writes as "3s", "500ms", ...
+type Duration time.Duration
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
 type EVMNetwork struct {
-	HttpUrl      string
-	WebsocketUrl string
-	ChainID      uint64
+	Name    string `json:"name" yaml:"name"`
+	ChainID uint64 `json:"chainId" yaml:"chainId"`
+
+	NativeCurrency NativeCurrency `json:"nativeCurrency" yaml:"nativeCurrency"`
+
+	// HttpUrl and WebsocketUrl are the primary endpoints, the fallback lists
+	// are tried in order when they are unreachable.
+	HttpUrl               string   `json:"httpUrl" yaml:"httpUrl"`
+	WebsocketUrl          string   `json:"websocketUrl" yaml:"websocketUrl"`
+	FallbackHttpUrls      []string `json:"fallbackHttpUrls,omitempty" yaml:"fallbackHttpUrls,omitempty"`
+	FallbackWebsocketUrls []string `json:"fallbackWebsocketUrls,omitempty" yaml:"fallbackWebsocketUrls,omitempty"`
+
+	ExplorerUrl    string         `json:"explorerUrl" yaml:"explorerUrl"`
+	ExplorerScheme ExplorerScheme `json:"explorerScheme,omitempty" yaml:"explorerScheme,omitempty"`
+
+	WrappedNative common.Address `json:"wrappedNative" yaml:"wrappedNative"`
+	Multicall     common.Address `json:"multicall" yaml:"multicall"`
+	Dexes         []DexConfig    `json:"dexes,omitempty" yaml:"dexes,omitempty"`
+
+	EIP1559          bool     `json:"eip1559" yaml:"eip1559"`
+	AverageBlockTime Duration `json:"averageBlockTime" yaml:"averageBlockTime"`
+}
+
+// HttpUrls returns the primary HTTP endpoint followed by the fallbacks.
+func (n *EVMNetwork) HttpUrls() []string {
+	return joinUrls(n.HttpUrl, n.FallbackHttpUrls)
+}
+
+// WebsocketUrls returns the primary websocket endpoint followed by the fallbacks.
+func (n *EVMNetwork) WebsocketUrls() []string {
+	return joinUrls(n.WebsocketUrl, n.FallbackWebsocketUrls)
+}
+
+// Dex returns the exchange registered under name, or nil.
+func (n *EVMNetwork) Dex(name string) *DexConfig {
+	for i := range n.Dexes {
+		if n.Dexes[i].Name == name {
+			return &n.Dexes[i]
+		}
+	}
+	return nil
 }
 
+func joinUrls(primary string, fallbacks []string) []string {
+	urls := make([]string, 0, len(fallbacks)+1)
+	if primary != "" {
+		urls = append(urls, primary)
+	}
+	return append(urls, fallbacks...)
+}
+
+var multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
 var AvalancheMainnet = &EVMNetwork{
-	HttpUrl:      "https://speedy-nodes-nyc.moralis.io/84a2745d907034e6d388f8d6/avalanche/mainnet",
-	WebsocketUrl: "wss://speedy-nodes-nyc.moralis.io/84a2745d907034e6d388f8d6/avalanche/mainnet/ws",
-	ChainID:      43114,
+	Name:           "avalanche",
+	ChainID:        43114,
+	NativeCurrency: NativeCurrency{Symbol: "AVAX", Decimals: 18},
+	HttpUrl:        "https://api.avax.network/ext/bc/C/rpc",
+	WebsocketUrl:   "wss://api.avax.network/ext/bc/C/ws",
+	ExplorerUrl:    "https://snowtrace.io",
+	WrappedNative:  common.HexToAddress("0xB31f66AA3C1e785363F0875A1B74E27b85FD66c7"),
+	Multicall:      multicall3Address,
+	Dexes: []DexConfig{
+		{
+			Name:    "traderjoe",
+			Router:  common.HexToAddress("0x60aE616a2155Ee3d9A68541Ba4544862310933d4"),
+			Factory: common.HexToAddress("0x9Ad6C38BE94206cA50bb0d90783181662f0Cfa10"),
+		},
+	},
+	EIP1559:          true,
+	AverageBlockTime: Duration(2 * time.Second),
 }
 
 var AvalancheFujiTesnet = &EVMNetwork{
-	HttpUrl:      "https://speedy-nodes-nyc.moralis.io/84a2745d907034e6d388f8d6/avalanche/testnet",
-	WebsocketUrl: "wss://speedy-nodes-nyc.moralis.io/84a2745d907034e6d388f8d6/avalanche/testnet/ws",
-	ChainID:      43113,
+	Name:             "avalanche-fuji",
+	ChainID:          43113,
+	NativeCurrency:   NativeCurrency{Symbol: "AVAX", Decimals: 18},
+	HttpUrl:          "https://api.avax-test.network/ext/bc/C/rpc",
+	WebsocketUrl:     "wss://api.avax-test.network/ext/bc/C/ws",
+	ExplorerUrl:      "https://testnet.snowtrace.io",
+	WrappedNative:    common.HexToAddress("0xd00ae08403B9bbb9124bB305C09058E32C39A48c"),
+	Multicall:        multicall3Address,
+	EIP1559:          true,
+	AverageBlockTime: Duration(2 * time.Second),
 }
 
 var BinanceSmartChainMainnet = &EVMNetwork{
-	HttpUrl:      "https://speedy-nodes-nyc.moralis.io/84a2745d907034e6d388f8d6/bsc/mainnet",
-	WebsocketUrl: "wss://speedy-nodes-nyc.moralis.io/84a2745d907034e6d388f8d6/bsc/mainnet/ws",
-	ChainID:      56,
+	Name:           "bsc",
+	ChainID:        56,
+	NativeCurrency: NativeCurrency{Symbol: "BNB", Decimals: 18},
+	HttpUrl:        "https://bsc-dataseed.binance.org",
+	FallbackHttpUrls: []string{
+		"https://bsc-dataseed1.defibit.io",
+		"https://bsc-dataseed1.ninicoin.io",
+	},
+	ExplorerUrl:   "https://bscscan.com",
+	WrappedNative: common.HexToAddress("0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c"),
+	Multicall:     multicall3Address,
+	Dexes: []DexConfig{
+		{
+			Name:    "pancakeswap",
+			Router:  common.HexToAddress("0x10ED43C718714eb63d5aA57B78B54704E256024E"),
+			Factory: common.HexToAddress("0xcA143Ce32Fe78f1f7019d7d551a6402fC5350c73"),
+		},
+	},
+	EIP1559:          false,
+	AverageBlockTime: Duration(3 * time.Second),
 }
 
 var BinanceSmartChainTestnet = &EVMNetwork{
-	HttpUrl:      "https://speedy-nodes-nyc.moralis.io/84a2745d907034e6d388f8d6/bsc/testnet",
-	WebsocketUrl: "wss://speedy-nodes-nyc.moralis.io/84a2745d907034e6d388f8d6/bsc/testnet/ws",
-	ChainID:      97,
+	Name:           "bsc-testnet",
+	ChainID:        97,
+	NativeCurrency: NativeCurrency{Symbol: "tBNB", Decimals: 18},
+	HttpUrl:        "https://data-seed-prebsc-1-s1.binance.org:8545",
+	FallbackHttpUrls: []string{
+		"https://data-seed-prebsc-2-s1.binance.org:8545",
+	},
+	ExplorerUrl:   "https://testnet.bscscan.com",
+	WrappedNative: common.HexToAddress("0xae13d989daC2f0dEbFf460aC112a837C89BAa7cd"),
+	Multicall:     multicall3Address,
+	Dexes: []DexConfig{
+		{
+			Name:    "pancakeswap",
+			Router:  common.HexToAddress("0x9Ac64Cc6e4415144C455BD8E4837Fea55603e5c3"),
+			Factory: common.HexToAddress("0xB7926C0430Afb07AA7DEfDE6DA862aE0Bde767bc"),
+		},
+	},
+	EIP1559:          false,
+	AverageBlockTime: Duration(3 * time.Second),
 }
@@ -0,0 +1,275 @@
+package web3helper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/mdp/qrterminal"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+var ErrTransactionMismatch = errors.New("signed transaction does not match the unsigned one")
+
+// UnsignedTransaction is a transaction built online to be signed on another
+// machine, usually an offline one, by From.
+type UnsignedTransaction struct {
+	ChainID *big.Int           `json:"chainId"`
+	From    common.Address     `json:"from"`
+	Tx      *types.Transaction `json:"tx"`
+}
+
+// unsignedEnvelope is the RLP encoding of an UnsignedTransaction, Tx in its
+// binary encoding.
+type unsignedEnvelope struct {
+	ChainID *big.Int
+	From    common.Address
+	Tx      []byte
+}
+
+// BuildUnsignedTransaction returns the transaction of msg, as built by
+// BuildTransaction, for signing elsewhere.
+func (w *Web3GolangHelper) BuildUnsignedTransaction(ctx context.Context, msg ethereum.CallMsg) (*UnsignedTransaction, error) {
+	chainID, err := w.cachedChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := w.BuildTransaction(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UnsignedTransaction{
+		ChainID: new(big.Int).Set(chainID),
+		From:    msg.From,
+		Tx:      tx,
+	}, nil
+}
+
+// MarshalBinary encodes u with RLP, the compact form used by Hex and QR
+// codes.
+func (u *UnsignedTransaction) MarshalBinary() ([]byte, error) {
+	tx, err := u.Tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(&unsignedEnvelope{ChainID: u.ChainID, From: u.From, Tx: tx})
+}
+
+func (u *UnsignedTransaction) UnmarshalBinary(data []byte) error {
+	var envelope unsignedEnvelope
+	if err := rlp.DecodeBytes(data, &envelope); err != nil {
+		return err
+	}
+
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(envelope.Tx); err != nil {
+		return err
+	}
+
+	u.ChainID = envelope.ChainID
+	u.From = envelope.From
+	u.Tx = tx
+	return nil
+}
+
+// Hex returns the RLP encoding of u in hex.
+func (u *UnsignedTransaction) Hex() (string, error) {
+	data, err := u.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	return hexutil.Encode(data), nil
+}
+
+// QRCode returns a PNG QR code of Hex, size pixels wide.
+func (u *UnsignedTransaction) QRCode(size int) ([]byte, error) {
+	encoded, err := u.Hex()
+	if err != nil {
+		return nil, err
+	}
+	return qrcode.Encode(encoded, qrcode.Medium, size)
+}
+
+// WriteQRCode draws the QR code of Hex on a terminal.
+func (u *UnsignedTransaction) WriteQRCode(out io.Writer) error {
+	encoded, err := u.Hex()
+	if err != nil {
+		return err
+	}
+
+	qrterminal.GenerateWithConfig(encoded, qrterminal.Config{
+		Level:     qrterminal.M,
+		Writer:    out,
+		BlackChar: qrterminal.BLACK,
+		WhiteChar: qrterminal.WHITE,
+		QuietZone: 1,
+	})
+	return nil
+}
+
+// ParseUnsignedTransaction decodes an UnsignedTransaction from its JSON or
+// hex RLP encoding.
+func ParseUnsignedTransaction(input []byte) (*UnsignedTransaction, error) {
+	input = bytes.TrimSpace(input)
+
+	u := new(UnsignedTransaction)
+	if bytes.HasPrefix(input, []byte("{")) {
+		if err := json.Unmarshal(input, u); err != nil {
+			return nil, err
+		}
+		if u.ChainID == nil || u.Tx == nil {
+			return nil, errors.New("unsigned transaction needs chainId and tx")
+		}
+		return u, nil
+	}
+
+	data, err := decodeHex(string(input))
+	if err != nil {
+		return nil, err
+	}
+	if err := u.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// SignUnsignedTransaction signs u with pk, which must be the key of u.From.
+// It needs no connection, for use on offline machines.
+func SignUnsignedTransaction(u *UnsignedTransaction, pk string) (*types.Transaction, error) {
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(pk, "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	if signer := crypto.PubkeyToAddress(privateKey.PublicKey); signer != u.From {
+		return nil, fmt.Errorf("%w: key of %s, expected %s", ErrTransactionMismatch, signer.Hex(), u.From.Hex())
+	}
+
+	return types.SignTx(u.Tx, types.LatestSignerForChainID(u.ChainID), privateKey)
+}
+
+// EncodeSignedTransaction returns the raw transaction broadcast by
+// eth_sendRawTransaction, in hex.
+func EncodeSignedTransaction(tx *types.Transaction) (string, error) {
+	data, err := tx.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	return hexutil.Encode(data), nil
+}
+
+// DecodeSignedTransaction decodes a raw transaction in hex.
+func DecodeSignedTransaction(raw string) (*types.Transaction, error) {
+	data, err := decodeHex(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// VerifySignedTransaction checks that signed is u signed by u.From.
+func VerifySignedTransaction(u *UnsignedTransaction, signed *types.Transaction) error {
+	sender, err := types.Sender(types.LatestSignerForChainID(u.ChainID), signed)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrTransactionMismatch, err)
+	}
+	if sender != u.From {
+		return fmt.Errorf("%w: signed by %s, expected %s", ErrTransactionMismatch, sender.Hex(), u.From.Hex())
+	}
+
+	expected := u.Tx
+	fields := []struct {
+		name          string
+		got, expected interface{}
+	}{
+		{"type", signed.Type(), expected.Type()},
+		{"nonce", signed.Nonce(), expected.Nonce()},
+		{"to", signed.To(), expected.To()},
+		{"value", signed.Value(), expected.Value()},
+		{"data", signed.Data(), expected.Data()},
+		{"gas", signed.Gas(), expected.Gas()},
+		{"gasPrice", signed.GasPrice(), expected.GasPrice()},
+		{"gasTipCap", signed.GasTipCap(), expected.GasTipCap()},
+		{"gasFeeCap", signed.GasFeeCap(), expected.GasFeeCap()},
+		{"accessList", signed.AccessList(), expected.AccessList()},
+	}
+	for _, field := range fields {
+		if !equalField(field.got, field.expected) {
+			return fmt.Errorf("%w: %s is %v, expected %v", ErrTransactionMismatch, field.name, field.got, field.expected)
+		}
+	}
+	return nil
+}
+
+func equalField(a, b interface{}) bool {
+	switch a := a.(type) {
+	case *big.Int:
+		return a.Cmp(b.(*big.Int)) == 0
+	case []byte:
+		return bytes.Equal(a, b.([]byte))
+	case types.AccessList:
+		// nil and empty lists encode the same
+		if len(a) == 0 {
+			return len(b.(types.AccessList)) == 0
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// BroadcastSignedTransaction sends the raw transaction signed from u on the
+// connected chain after checking it with VerifySignedTransaction.
+func (w *Web3GolangHelper) BroadcastSignedTransaction(ctx context.Context, u *UnsignedTransaction, raw string) (*types.Transaction, error) {
+	signed, err := DecodeSignedTransaction(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := w.cachedChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if u.ChainID.Cmp(chainID) != 0 {
+		return nil, fmt.Errorf("%w: transaction for chain %s, connected to %s", ErrChainIDMismatch, u.ChainID, chainID)
+	}
+
+	if err := VerifySignedTransaction(u, signed); err != nil {
+		return nil, err
+	}
+
+	if err := w.sendTransaction(ctx, signed); err != nil {
+		if revert := w.DecodeRevert(err); revert != nil {
+			return nil, revert
+		}
+		return nil, err
+	}
+
+	w.notifyTxSent(signed)
+	return signed, nil
+}
+
+func decodeHex(input string) ([]byte, error) {
+	input = strings.TrimSpace(input)
+	if !strings.HasPrefix(input, "0x") && !strings.HasPrefix(input, "0X") {
+		input = "0x" + input
+	}
+	return hexutil.Decode(input)
+}
@@ -0,0 +1,323 @@
+package web3helper
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	ccolor "github.com/fatih/color"
+)
+
+// Field is one named value of a result.
+type Field struct {
+	Name  string
+	Value interface{}
+}
+
+// Fields is an ordered set of fields. Renderers keep the order, JSON included.
+type Fields []Field
+
+// Result is any value that can be rendered.
+type Result interface {
+	Fields() Fields
+}
+
+func (f Fields) Fields() Fields {
+	return f
+}
+
+func (f Fields) MarshalJSON() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteByte('{')
+
+	for i, field := range f {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		name, err := json.Marshal(field.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(field.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// Renderer writes results in one output format. Render writes a single result,
+// RenderList a collection, which matters for JSON (object vs array) and CSV
+// (one header for every row).
+type Renderer interface {
+	Render(out io.Writer, result Result) error
+	RenderList(out io.Writer, results []Result) error
+}
+
+const (
+	TextFormat   = "text"
+	JSONFormat   = "json"
+	NDJSONFormat = "ndjson"
+	CSVFormat    = "csv"
+)
+
+// NewRenderer returns the renderer of format. Text output is colored when color is set.
+func NewRenderer(format string, color bool) (Renderer, error) {
+	switch strings.ToLower(format) {
+	case TextFormat, "":
+		return &TextRenderer{Color: color}, nil
+	case JSONFormat:
+		return &JSONRenderer{}, nil
+	case NDJSONFormat:
+		return &NDJSONRenderer{}, nil
+	case CSVFormat:
+		return &CSVRenderer{}, nil
+	}
+	return nil, fmt.Errorf("unknown output format %q", format)
+}
+
+// TextRenderer writes "name: value" lines for humans.
+type TextRenderer struct {
+	Color bool
+}
+
+func (r *TextRenderer) Render(out io.Writer, result Result) error {
+	for _, field := range result.Fields() {
+		name := field.Name + ": "
+		value := formatValue(field.Value)
+		if r.Color {
+			name = ccolor.CyanString(name)
+			value = ccolor.YellowString(value)
+		}
+
+		if _, err := fmt.Fprintln(out, name+value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *TextRenderer) RenderList(out io.Writer, results []Result) error {
+	for i, result := range results {
+		if i > 0 {
+			if _, err := fmt.Fprintln(out); err != nil {
+				return err
+			}
+		}
+		if err := r.Render(out, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSONRenderer writes one object per result and arrays for lists.
+type JSONRenderer struct{}
+
+func (r *JSONRenderer) Render(out io.Writer, result Result) error {
+	return json.NewEncoder(out).Encode(result.Fields())
+}
+
+func (r *JSONRenderer) RenderList(out io.Writer, results []Result) error {
+	list := make([]Fields, 0, len(results))
+	for _, result := range results {
+		list = append(list, result.Fields())
+	}
+	return json.NewEncoder(out).Encode(list)
+}
+
+// NDJSONRenderer writes one JSON object per line, lists included, so output
+// can be streamed.
+type NDJSONRenderer struct{}
+
+func (r *NDJSONRenderer) Render(out io.Writer, result Result) error {
+	return json.NewEncoder(out).Encode(result.Fields())
+}
+
+func (r *NDJSONRenderer) RenderList(out io.Writer, results []Result) error {
+	for _, result := range results {
+		if err := r.Render(out, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CSVRenderer writes the field names of the first result as header and one
+// row per result. The header is written once per renderer.
+type CSVRenderer struct {
+	headerWritten bool
+}
+
+func (r *CSVRenderer) Render(out io.Writer, result Result) error {
+	return r.RenderList(out, []Result{result})
+}
+
+func (r *CSVRenderer) RenderList(out io.Writer, results []Result) error {
+	writer := csv.NewWriter(out)
+
+	for _, result := range results {
+		fields := result.Fields()
+
+		if !r.headerWritten {
+			header := make([]string, 0, len(fields))
+			for _, field := range fields {
+				header = append(header, field.Name)
+			}
+			if err := writer.Write(header); err != nil {
+				return err
+			}
+			r.headerWritten = true
+		}
+
+		row := make([]string, 0, len(fields))
+		for _, field := range fields {
+			row = append(row, formatValue(field.Value))
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case []string:
+		return strings.Join(v, ";")
+	case []byte:
+		return hexutil.Encode(v)
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case fmt.Stringer:
+		return v.String()
+	}
+	return fmt.Sprint(value)
+}
+
+// TxResult describes a transaction sent by the helper.
+type TxResult struct {
+	Hash        string
+	From        string
+	To          string
+	Nonce       uint64
+	Value       *big.Int
+	GasLimit    uint64
+	GasPrice    *big.Int
+	GasTipCap   *big.Int
+	GasFeeCap   *big.Int
+	Type        uint8
+	ChainID     *big.Int
+	Data        hexutil.Bytes
+	Timestamp   time.Time
+	ExplorerUrl string
+}
+
+// NewTxResult builds the result of a signed transaction.
+func NewTxResult(signedTx *types.Transaction) *TxResult {
+	result := &TxResult{
+		Hash:      signedTx.Hash().Hex(),
+		Nonce:     signedTx.Nonce(),
+		Value:     signedTx.Value(),
+		GasLimit:  signedTx.Gas(),
+		Type:      signedTx.Type(),
+		ChainID:   signedTx.ChainId(),
+		Data:      signedTx.Data(),
+		Timestamp: time.Now(),
+	}
+
+	if signedTx.Type() == types.DynamicFeeTxType {
+		result.GasTipCap = signedTx.GasTipCap()
+		result.GasFeeCap = signedTx.GasFeeCap()
+	} else {
+		result.GasPrice = signedTx.GasPrice()
+	}
+
+	if signedTx.To() != nil {
+		result.To = signedTx.To().Hex()
+	}
+
+	if from, err := types.Sender(types.LatestSignerForChainID(signedTx.ChainId()), signedTx); err == nil {
+		result.From = from.Hex()
+	}
+
+	return result
+}
+
+func (r *TxResult) Fields() Fields {
+	fields := Fields{
+		{"hash", r.Hash},
+		{"from", r.From},
+		{"to", r.To},
+		{"nonce", r.Nonce},
+		{"value", r.Value},
+		{"gasLimit", r.GasLimit},
+	}
+
+	if r.GasPrice != nil {
+		fields = append(fields, Field{"gasPrice", r.GasPrice})
+	} else {
+		fields = append(fields, Field{"gasTipCap", r.GasTipCap}, Field{"gasFeeCap", r.GasFeeCap})
+	}
+
+	return append(fields,
+		Field{"type", r.Type},
+		Field{"chainId", r.ChainID},
+		Field{"data", r.Data},
+		Field{"timestamp", r.Timestamp.Unix()},
+		Field{"explorerUrl", r.ExplorerUrl},
+	)
+}
+
+func (i *TokenInfo) Fields() Fields {
+	return Fields{
+		{"address", i.Address.Hex()},
+		{"name", i.Name},
+		{"symbol", i.Symbol},
+		{"decimals", i.Decimals},
+		{"totalSupply", i.TotalSupply},
+	}
+}
+
+func (r *PairReserves) Fields() Fields {
+	return Fields{
+		{"pair", r.Pair.Hex()},
+		{"token0", r.Token0.Hex()},
+		{"token1", r.Token1.Hex()},
+		{"reserve0", r.Reserve0},
+		{"reserve1", r.Reserve1},
+		{"blockTimestampLast", r.BlockTimestampLast},
+	}
+}
+
+// SetResultOutput makes the helper render the result of every transaction it
+// sends to out. A nil renderer disables it, which is the default.
+func (w *Web3GolangHelper) SetResultOutput(renderer Renderer, out io.Writer) {
+	w.resultRenderer = renderer
+	w.resultWriter = out
+}
+
+func (w *Web3GolangHelper) renderResult(result Result) {
+	if w.resultRenderer == nil || w.resultWriter == nil {
+		return
+	}
+	_ = w.resultRenderer.Render(w.resultWriter, result)
+}
@@ -0,0 +1,235 @@
+package pancaketest
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/nikola43/web3golanghelper/web3helper/pancaketest/internal/evmasm"
+)
+
+// The mock contracts are assembled with evmasm instead of compiled from
+// Solidity, they implement the subset of WETH9, PancakePair and
+// PancakeFactory used by PancakeRouter and the helper.
+//
+// Memory layout: 0x00-0x3f hashing scratch and call return data, locals from
+// 0x80, return and event data from 0x280, outgoing call data from 0x300.
+// Function bodies use locals 0-9, the macros below 10-15.
+const (
+	localsOffset = 0x80
+	outOffset    = 0x280
+	callOffset   = 0x300
+)
+
+type expr = evmasm.Expr
+
+var (
+	op  = evmasm.Op
+	num = evmasm.Num
+)
+
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+func add(a, b expr) expr        { return op(vm.ADD, a, b) }
+func sub(a, b expr) expr        { return op(vm.SUB, a, b) }
+func mul(a, b expr) expr        { return op(vm.MUL, a, b) }
+func div(a, b expr) expr        { return op(vm.DIV, a, b) }
+func mod(a, b expr) expr        { return op(vm.MOD, a, b) }
+func lt(a, b expr) expr         { return op(vm.LT, a, b) }
+func gt(a, b expr) expr         { return op(vm.GT, a, b) }
+func eq(a, b expr) expr         { return op(vm.EQ, a, b) }
+func iszero(a expr) expr        { return op(vm.ISZERO, a) }
+func and(a, b expr) expr        { return op(vm.AND, a, b) }
+func or(a, b expr) expr         { return op(vm.OR, a, b) }
+func shl(n uint64, a expr) expr { return op(vm.SHL, num(n), a) }
+func sload(slot expr) expr      { return op(vm.SLOAD, slot) }
+func mload(offset expr) expr    { return op(vm.MLOAD, offset) }
+
+func sstore(slot, value expr) expr   { return op(vm.SSTORE, slot, value) }
+func mstore(offset, value expr) expr { return op(vm.MSTORE, offset, value) }
+
+func caller() expr  { return op(vm.CALLER) }
+func address() expr { return op(vm.ADDRESS) }
+
+// geq is a >= b.
+func geq(a, b expr) expr { return iszero(lt(a, b)) }
+
+func local(i int) expr { return mload(num(uint64(localsOffset + 32*i))) }
+
+func setLocal(p *evmasm.Program, i int, value expr) {
+	p.Do(mstore(num(uint64(localsOffset+32*i)), value))
+}
+
+// arg loads the i-th static argument of the call.
+func arg(i int) expr { return op(vm.CALLDATALOAD, num(uint64(4+32*i))) }
+
+func addressArg(i int) expr {
+	return and(arg(i), evmasm.Big(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 160), big.NewInt(1))))
+}
+
+// mapSlot is the storage slot of key in the mapping at slot. key must not use
+// the hashing scratch space.
+func mapSlot(slot, key expr) expr {
+	return func(p *evmasm.Program) {
+		p.Do(mstore(num(0x20), slot), mstore(num(0), key))
+		op(vm.KECCAK256, num(0), num(0x40))(p)
+	}
+}
+
+func selector(signature string) []byte {
+	return crypto.Keccak256([]byte(signature))[:4]
+}
+
+// ret returns words ABI encoded.
+func ret(p *evmasm.Program, words ...expr) {
+	for i, word := range words {
+		p.Do(mstore(num(uint64(outOffset+32*i)), word))
+	}
+	p.Do(op(vm.RETURN, num(outOffset), num(uint64(32*len(words)))))
+}
+
+// retString returns s, at most 32 bytes, ABI encoded.
+func retString(p *evmasm.Program, s string) {
+	word := make([]byte, 32)
+	copy(word, s)
+	p.Do(
+		mstore(num(outOffset), num(0x20)),
+		mstore(num(outOffset+0x20), num(uint64(len(s)))),
+		mstore(num(outOffset+0x40), evmasm.Bytes(word)),
+		op(vm.RETURN, num(outOffset), num(0x60)),
+	)
+}
+
+// revert reverts with Error(reason), reason at most 32 bytes.
+func revert(p *evmasm.Program, reason string) {
+	word := make([]byte, 32)
+	copy(word, reason)
+	p.Do(
+		mstore(num(0), shl(224, evmasm.Bytes(selector("Error(string)")))),
+		mstore(num(4), num(0x20)),
+		mstore(num(0x24), num(uint64(len(reason)))),
+		mstore(num(0x44), evmasm.Bytes(word)),
+		op(vm.REVERT, num(0), num(0x64)),
+	)
+}
+
+func require(p *evmasm.Program, cond expr, reason string) {
+	p.If(iszero(cond), func() {
+		revert(p, reason)
+	})
+}
+
+// emit logs the event with signature, topics being its indexed arguments.
+func emit(p *evmasm.Program, signature string, topics []expr, data ...expr) {
+	for i, word := range data {
+		p.Do(mstore(num(uint64(outOffset+32*i)), word))
+	}
+
+	args := []expr{num(outOffset), num(uint64(32 * len(data))), evmasm.Bytes(crypto.Keccak256([]byte(signature)))}
+	args = append(args, topics...)
+	p.Do(op(vm.LOG0+vm.OpCode(len(topics)+1), args...))
+}
+
+// call stores the call data of signature and pushes the success flag of the
+// call, the first word of the return data lands at 0.
+func call(callOp vm.OpCode, target expr, value expr, signature string, args ...expr) expr {
+	return func(p *evmasm.Program) {
+		p.Do(mstore(num(callOffset), shl(224, evmasm.Bytes(selector(signature)))))
+		for i, arg := range args {
+			p.Do(mstore(num(uint64(callOffset+4+32*i)), arg))
+		}
+
+		size := num(uint64(4 + 32*len(args)))
+		if callOp == vm.STATICCALL {
+			op(vm.STATICCALL, op(vm.GAS), target, num(callOffset), size, num(0), num(0x20))(p)
+		} else {
+			op(vm.CALL, op(vm.GAS), target, value, num(callOffset), size, num(0), num(0x20))(p)
+		}
+	}
+}
+
+// safeTransfer calls token.transfer(to, amount) and reverts unless it
+// succeeds, accepting tokens that return nothing like TransferHelper does.
+func safeTransfer(p *evmasm.Program, token, to, amount expr) {
+	setLocal(p, 15, call(vm.CALL, token, num(0), "transfer(address,uint256)", to, amount))
+	require(p, and(local(15), or(iszero(op(vm.RETURNDATASIZE)), eq(mload(num(0)), num(1)))), "TRANSFER_FAILED")
+}
+
+// balanceOf pushes token.balanceOf(account).
+func balanceOf(token, account expr) expr {
+	return func(p *evmasm.Program) {
+		setLocal(p, 15, call(vm.STATICCALL, token, nil, "balanceOf(address)", account))
+		require(p, local(15), "BALANCE_FAILED")
+		mload(num(0))(p)
+	}
+}
+
+// contract is the runtime code of a mock: a selector dispatch followed by the
+// function bodies.
+type contract struct {
+	functions []function
+	fallback  func(p *evmasm.Program)
+	data      []data
+}
+
+type data struct {
+	label string
+	bytes []byte
+}
+
+type function struct {
+	selector []byte
+	body     func(p *evmasm.Program)
+}
+
+func (c *contract) function(signature string, body func(p *evmasm.Program)) {
+	c.functions = append(c.functions, function{selector: selector(signature), body: body})
+}
+
+func (c *contract) assemble() ([]byte, error) {
+	p := evmasm.New()
+
+	p.Do(op(vm.SHR, num(224), op(vm.CALLDATALOAD, num(0))))
+	labels := make([]string, len(c.functions))
+	for i, fn := range c.functions {
+		labels[i] = p.NewLabel()
+		p.Do(op(vm.DUP1))
+		p.JumpIf(op(vm.EQ, evmasm.Bytes(fn.selector)), labels[i])
+	}
+
+	if c.fallback != nil {
+		c.fallback(p)
+		p.Do(op(vm.STOP))
+	} else {
+		p.Do(op(vm.REVERT, num(0), num(0)))
+	}
+
+	for i, fn := range c.functions {
+		p.Mark(labels[i])
+		fn.body(p)
+		p.Do(op(vm.STOP))
+	}
+
+	for _, section := range c.data {
+		p.Data(section.label, section.bytes)
+	}
+	return p.Assemble()
+}
+
+// sqrt stores the integer square root of y, a local index, in local dst.
+func sqrt(p *evmasm.Program, dst int, y int) {
+	setLocal(p, dst, num(0))
+	p.IfElse(gt(local(y), num(3)), func() {
+		setLocal(p, dst, local(y))
+		setLocal(p, 10, add(div(local(y), num(2)), num(1)))
+		p.While(lt(local(10), local(dst)), func() {
+			setLocal(p, dst, local(10))
+			setLocal(p, 10, div(add(div(local(y), local(10)), local(10)), num(2)))
+		})
+	}, func() {
+		p.If(local(y), func() {
+			setLocal(p, dst, num(1))
+		})
+	})
+}
@@ -0,0 +1,450 @@
+// Package pancaketest deploys WETH, a Pancake factory, PancakeRouter and mock
+// ERC20 tokens with seeded liquidity to a simulated chain, so Buy, GetPair,
+// GetReserves and swaps run end to end without a network.
+//
+//	h, err := pancaketest.New(pancaketest.TokenConfig{
+//		Symbol:          "CAKE",
+//		LiquidityTokens: web3helper.EtherToWei(big.NewFloat(100000)),
+//		LiquidityETH:    web3helper.EtherToWei(big.NewFloat(100)),
+//	})
+//	defer h.Close()
+//
+//	txHash, err := h.Helper.Buy(h.Buyer, h.Token("CAKE").Address.Hex(), 0.1, h.BuyerKey)
+//	h.Commit()
+//
+// Transactions stay pending until Commit mines them. Helper streams the ones it
+// sends to SubscribePendingTransactions.
+package pancaketest
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+
+	pancakeFactory "github.com/nikola43/web3golanghelper/contracts/bindings/ipancakefactory"
+	pancakeRouter "github.com/nikola43/web3golanghelper/contracts/bindings/ipancakerouter02"
+	pancakeRouterBuild "github.com/nikola43/web3golanghelper/contracts/bindings/pancakerouter"
+	"github.com/nikola43/web3golanghelper/web3helper"
+)
+
+// ChainID is the chain ID of the simulated chain.
+var ChainID = big.NewInt(1337)
+
+// GasLimit is the block gas limit, above the gas limit Buy sends with.
+const GasLimit = 30000000
+
+// DexName is the name of the exchange in Network.
+const DexName = "pancakeswap"
+
+// routerInitCodeHash is the pair init code hash compiled into PancakeRouter,
+// replaced by the hash of the mock pair on deploy.
+var routerInitCodeHash = common.FromHex("ecba335299a6693cb2ebc4782e74669b84290b6378ea3a3873c7231a8d7d1074")
+
+var (
+	deployerBalance = new(big.Int).Mul(big.NewInt(1000000), big.NewInt(1e18))
+	buyerBalance    = new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))
+)
+
+// TokenConfig describes a mock ERC20 token.
+type TokenConfig struct {
+	Name   string
+	Symbol string
+
+	// Decimals defaults to 18.
+	Decimals uint8
+
+	// Supply is minted to the deployer, it defaults to the liquidity amount
+	// plus one million tokens.
+	Supply *big.Int
+
+	// TransferFeeBps is burned from every transfer, in basis points.
+	TransferFeeBps uint64
+
+	// Blacklisted accounts can neither send nor receive the token, set after
+	// liquidity is added.
+	Blacklisted []common.Address
+
+	// LiquidityTokens and LiquidityETH seed the token/WETH pair, which is only
+	// created when both are set.
+	LiquidityTokens *big.Int
+	LiquidityETH    *big.Int
+}
+
+// Token is a deployed mock token.
+type Token struct {
+	TokenConfig
+
+	Address common.Address
+
+	// Pair is the token/WETH pair, zero without liquidity.
+	Pair common.Address
+
+	harness  *Harness
+	contract *bind.BoundContract
+}
+
+type Harness struct {
+	Backend *backends.SimulatedBackend
+	Helper  *web3helper.Web3GolangHelper
+
+	// Network describes the simulated chain with the deployed exchange as
+	// its only dex, it is set on Helper.
+	Network *web3helper.EVMNetwork
+
+	// Deployer owns the contracts and the token supplies.
+	Deployer    common.Address
+	DeployerKey string
+
+	// Buyer is an account funded with ETH for Helper.Buy to sign with.
+	Buyer    common.Address
+	BuyerKey string
+
+	WETH    common.Address
+	Factory common.Address
+	Router  common.Address
+
+	Tokens []*Token
+
+	key  *ecdsa.PrivateKey
+	auth *bind.TransactOpts
+}
+
+// New deploys the exchange and tokens and returns the harness with a helper
+// connected to the simulated chain.
+//
+// PancakeRouter exceeds the EIP-170 code size limit, so the exchange is placed
+// in the genesis block at the addresses of the first three contracts of the
+// deployer rather than deployed by transactions.
+func New(tokens ...TokenConfig) (*Harness, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	buyerKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	h := &Harness{
+		Deployer:    crypto.PubkeyToAddress(key.PublicKey),
+		DeployerKey: common.Bytes2Hex(crypto.FromECDSA(key)),
+		Buyer:       crypto.PubkeyToAddress(buyerKey.PublicKey),
+		BuyerKey:    common.Bytes2Hex(crypto.FromECDSA(buyerKey)),
+		key:         key,
+	}
+
+	if h.auth, err = bind.NewKeyedTransactorWithChainID(key, ChainID); err != nil {
+		return nil, err
+	}
+
+	alloc, err := h.exchangeAlloc()
+	if err != nil {
+		return nil, err
+	}
+	h.Backend = backends.NewSimulatedBackend(alloc, GasLimit)
+
+	for _, config := range tokens {
+		if _, err := h.AddToken(config); err != nil {
+			h.Close()
+			return nil, err
+		}
+	}
+
+	if err := h.connectHelper(); err != nil {
+		h.Close()
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// exchangeAlloc returns the genesis accounts: the funded deployer, WETH, the
+// factory and the router.
+func (h *Harness) exchangeAlloc() (core.GenesisAlloc, error) {
+	h.WETH = crypto.CreateAddress(h.Deployer, 0)
+	h.Factory = crypto.CreateAddress(h.Deployer, 1)
+	h.Router = crypto.CreateAddress(h.Deployer, 2)
+
+	wethRuntime, err := wethCode()
+	if err != nil {
+		return nil, err
+	}
+
+	pairInitCode, err := pairCode()
+	if err != nil {
+		return nil, err
+	}
+	factoryRuntime, err := factoryCode(pairInitCode)
+	if err != nil {
+		return nil, err
+	}
+
+	routerRuntime, err := h.routerCode(crypto.Keccak256(pairInitCode))
+	if err != nil {
+		return nil, fmt.Errorf("deploy router: %w", err)
+	}
+
+	return core.GenesisAlloc{
+		h.Deployer: {Balance: deployerBalance, Nonce: 3},
+		h.WETH:     {Code: wethRuntime, Balance: new(big.Int)},
+		h.Factory: {
+			Code:    factoryRuntime,
+			Balance: new(big.Int),
+			Storage: map[common.Hash]common.Hash{common.BigToHash(big.NewInt(feeToSetterSlot)): common.BytesToHash(h.Deployer.Bytes())},
+		},
+		h.Router: {Code: routerRuntime, Balance: new(big.Int)},
+	}, nil
+}
+
+// routerCode runs the PancakeRouter constructor, with the pair init code hash
+// replaced by pairInitCodeHash, on an EVM without code size limit and returns
+// the runtime code.
+func (h *Harness) routerCode(pairInitCodeHash []byte) ([]byte, error) {
+	initCode := common.FromHex(pancakeRouterBuild.PancakeRouterMetaData.Bin)
+	if bytes.Count(initCode, routerInitCodeHash) != 1 {
+		return nil, errors.New("pair init code hash not found in PancakeRouter bytecode")
+	}
+	initCode = bytes.Replace(initCode, routerInitCodeHash, pairInitCodeHash, 1)
+
+	parsed, err := abi.JSON(strings.NewReader(pancakeRouterBuild.PancakeRouterMetaData.ABI))
+	if err != nil {
+		return nil, err
+	}
+	args, err := parsed.Pack("", h.Factory, h.WETH)
+	if err != nil {
+		return nil, err
+	}
+
+	config := *params.AllEthashProtocolChanges
+	config.EIP158Block = nil // EIP-170 applies from EIP-158
+
+	code, _, _, err := runtime.Create(append(initCode, args...), &runtime.Config{
+		ChainConfig: &config,
+		Origin:      h.Deployer,
+		GasLimit:    GasLimit,
+	})
+	return code, err
+}
+
+func (h *Harness) connectHelper() error {
+	helper, err := web3helper.NewWeb3GolangHelperFromBackend(&pendingBackend{SimulatedBackend: h.Backend}, ChainID)
+	if err != nil {
+		return err
+	}
+
+	h.Network = &web3helper.EVMNetwork{
+		Name:           "pancaketest",
+		ChainID:        ChainID.Uint64(),
+		NativeCurrency: web3helper.NativeCurrency{Symbol: "ETH", Decimals: 18},
+		WrappedNative:  h.WETH,
+		Dexes: []web3helper.DexConfig{
+			{Name: DexName, Router: h.Router, Factory: h.Factory},
+		},
+		EIP1559: true,
+	}
+	if err := helper.SetNetwork(h.Network); err != nil {
+		return err
+	}
+	h.Helper = helper
+
+	return h.Fund(h.Buyer, buyerBalance)
+}
+
+// deploy sends init code and mines it.
+func (h *Harness) deploy(initCode []byte) (common.Address, error) {
+	address, tx, _, err := bind.DeployContract(h.transactor(nil), abi.ABI{}, initCode, h.Backend)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if err := h.mine(tx); err != nil {
+		return common.Address{}, err
+	}
+	return address, nil
+}
+
+// AddToken deploys a mock token, seeding its WETH pair when the config has
+// liquidity.
+func (h *Harness) AddToken(config TokenConfig) (*Token, error) {
+	if config.Decimals == 0 {
+		config.Decimals = 18
+	}
+	if config.Name == "" {
+		config.Name = config.Symbol
+	}
+	if config.Supply == nil {
+		unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(config.Decimals)), nil)
+		config.Supply = new(big.Int).Mul(big.NewInt(1000000), unit)
+		if config.LiquidityTokens != nil {
+			config.Supply.Add(config.Supply, config.LiquidityTokens)
+		}
+	}
+
+	initCode, err := tokenCode(config.Name, config.Symbol, config.Decimals, config.Supply, config.TransferFeeBps)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := h.deploy(initCode)
+	if err != nil {
+		return nil, fmt.Errorf("deploy %s: %w", config.Symbol, err)
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(MockTokenABI))
+	if err != nil {
+		return nil, err
+	}
+
+	token := &Token{
+		TokenConfig: config,
+		Address:     address,
+		harness:     h,
+		contract:    bind.NewBoundContract(address, parsed, h.Backend, h.Backend, h.Backend),
+	}
+
+	if config.LiquidityTokens != nil && config.LiquidityETH != nil {
+		if err := token.addLiquidity(config.LiquidityTokens, config.LiquidityETH); err != nil {
+			return nil, fmt.Errorf("add %s liquidity: %w", config.Symbol, err)
+		}
+	}
+
+	for _, account := range config.Blacklisted {
+		if err := token.SetBlacklisted(account, true); err != nil {
+			return nil, err
+		}
+	}
+
+	h.Tokens = append(h.Tokens, token)
+	return token, nil
+}
+
+func (t *Token) addLiquidity(tokens, eth *big.Int) error {
+	h := t.harness
+
+	if err := t.transact("approve", h.Router, tokens); err != nil {
+		return err
+	}
+
+	router, err := pancakeRouter.NewIPancakeRouter02Transactor(h.Router, h.Backend)
+	if err != nil {
+		return err
+	}
+
+	tx, err := router.AddLiquidityETH(h.transactor(eth), t.Address, tokens, big.NewInt(0), big.NewInt(0), h.Deployer, h.deadline())
+	if err != nil {
+		return err
+	}
+	if err := h.mine(tx); err != nil {
+		return err
+	}
+
+	factory, err := pancakeFactory.NewIPancakeFactoryCaller(h.Factory, h.Backend)
+	if err != nil {
+		return err
+	}
+
+	t.Pair, err = factory.GetPair(&bind.CallOpts{}, h.WETH, t.Address)
+	return err
+}
+
+// Token returns the token with symbol, nil if there is none.
+func (h *Harness) Token(symbol string) *Token {
+	for _, token := range h.Tokens {
+		if token.Symbol == symbol {
+			return token
+		}
+	}
+	return nil
+}
+
+// Mint creates amount tokens for account.
+func (t *Token) Mint(account common.Address, amount *big.Int) error {
+	return t.transact("mint", account, amount)
+}
+
+func (t *Token) SetBlacklisted(account common.Address, blacklisted bool) error {
+	return t.transact("setBlacklisted", account, blacklisted)
+}
+
+// transact calls method as the deployer and mines the transaction.
+func (t *Token) transact(method string, args ...interface{}) error {
+	tx, err := t.contract.Transact(t.harness.transactor(nil), method, args...)
+	if err != nil {
+		return err
+	}
+	return t.harness.mine(tx)
+}
+
+// Fund sends amount wei from the deployer to account and mines it.
+func (h *Harness) Fund(account common.Address, amount *big.Int) error {
+	ctx := context.Background()
+
+	nonce, err := h.Backend.PendingNonceAt(ctx, h.Deployer)
+	if err != nil {
+		return err
+	}
+	gasPrice, err := h.Backend.SuggestGasPrice(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := types.SignTx(types.NewTransaction(nonce, account, amount, 21000, gasPrice, nil), types.LatestSignerForChainID(ChainID), h.key)
+	if err != nil {
+		return err
+	}
+	if err := h.Backend.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	return h.mine(tx)
+}
+
+// Commit mines the pending transactions.
+func (h *Harness) Commit() {
+	h.Backend.Commit()
+}
+
+func (h *Harness) Close() error {
+	return h.Backend.Close()
+}
+
+func (h *Harness) transactor(value *big.Int) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From:    h.auth.From,
+		Signer:  h.auth.Signer,
+		Value:   value,
+		Context: context.Background(),
+	}
+}
+
+func (h *Harness) deadline() *big.Int {
+	return big.NewInt(time.Now().Add(time.Hour).Unix())
+}
+
+// mine commits tx and fails when it reverted.
+func (h *Harness) mine(tx *types.Transaction) error {
+	h.Backend.Commit()
+
+	receipt, err := h.Backend.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s reverted", tx.Hash().Hex())
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package pancaketest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/nikola43/web3golanghelper/contracts/bindings/ierc20"
+	pancakeRouter "github.com/nikola43/web3golanghelper/contracts/bindings/ipancakerouter02"
+	"github.com/nikola43/web3golanghelper/web3helper"
+)
+
+func ether(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e18))
+}
+
+func newHarness(t *testing.T, tokens ...TokenConfig) *Harness {
+	t.Helper()
+
+	h, err := New(tokens...)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	t.Cleanup(func() { h.Close() })
+	return h
+}
+
+func tokenBalance(t *testing.T, h *Harness, token, account common.Address) *big.Int {
+	t.Helper()
+
+	caller, err := ierc20.NewIERC20Caller(token, h.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	balance, err := caller.BalanceOf(&bind.CallOpts{}, account)
+	if err != nil {
+		t.Fatalf("balanceOf: %v", err)
+	}
+	return balance
+}
+
+// expectRevert fails unless err is a revert with reason.
+func expectRevert(t *testing.T, err error, reason string) {
+	t.Helper()
+
+	if err == nil || !strings.Contains(err.Error(), reason) {
+		t.Fatalf("got error %v, want a revert with %s", err, reason)
+	}
+}
+
+func TestHarnessBuy(t *testing.T) {
+	h := newHarness(t, TokenConfig{
+		Symbol:          "CAKE",
+		LiquidityTokens: ether(100000),
+		LiquidityETH:    ether(100),
+	})
+	cake := h.Token("CAKE")
+
+	pair, err := h.Helper.GetPair(cake.Address.Hex())
+	if err != nil {
+		t.Fatalf("GetPair: %v", err)
+	}
+	if cake.Pair == (common.Address{}) || common.HexToAddress(pair) != cake.Pair {
+		t.Fatalf("GetPair = %s, want %s", pair, cake.Pair.Hex())
+	}
+
+	reserves := h.Helper.GetReserves(pair)
+	tokenReserve, ethReserve := reserves.Reserve0, reserves.Reserve1
+	if bytes.Compare(h.WETH.Bytes(), cake.Address.Bytes()) < 0 {
+		tokenReserve, ethReserve = ethReserve, tokenReserve
+	}
+	if tokenReserve.Cmp(ether(100000)) != 0 || ethReserve.Cmp(ether(100)) != 0 {
+		t.Fatalf("reserves = %s tokens / %s wei, want the seeded liquidity", tokenReserve, ethReserve)
+	}
+
+	router, err := pancakeRouter.NewIPancakeRouter02Caller(h.Router, h.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	amounts, err := router.GetAmountsOut(&bind.CallOpts{}, web3helper.EtherToWei(big.NewFloat(0.1)), []common.Address{h.WETH, cake.Address})
+	if err != nil {
+		t.Fatalf("getAmountsOut: %v", err)
+	}
+
+	txHash, err := h.Helper.Buy(h.Buyer, cake.Address.Hex(), 0.1, h.BuyerKey)
+	if err != nil {
+		t.Fatalf("Buy: %v", err)
+	}
+	h.Commit()
+
+	receipt, err := h.Backend.TransactionReceipt(context.Background(), common.HexToHash(txHash))
+	if err != nil {
+		t.Fatalf("receipt: %v", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("buy %s reverted", txHash)
+	}
+
+	if balance := tokenBalance(t, h, cake.Address, h.Buyer); balance.Cmp(amounts[1]) != 0 {
+		t.Fatalf("buyer balance = %s, want %s", balance, amounts[1])
+	}
+}
+
+func TestHarnessBuyRejectsBadKey(t *testing.T) {
+	h := newHarness(t, TokenConfig{
+		Symbol:          "CAKE",
+		LiquidityTokens: ether(1000),
+		LiquidityETH:    ether(1),
+	})
+
+	if _, err := h.Helper.Buy(h.Buyer, h.Token("CAKE").Address.Hex(), 0.1, "not a key"); err == nil {
+		t.Fatal("Buy signed without a valid key")
+	}
+}
+
+func TestHarnessCancelRejectsOtherKey(t *testing.T) {
+	h := newHarness(t)
+
+	txHash, _, err := h.Helper.SendEth(h.Buyer, h.Deployer.Hex(), "0.01", h.BuyerKey)
+	if err != nil {
+		t.Fatalf("SendEth: %v", err)
+	}
+
+	if _, err := h.Helper.CancelPendingTransaction(context.Background(), txHash, 10, h.DeployerKey); !errors.Is(err, web3helper.ErrNotSender) {
+		t.Fatalf("CancelPendingTransaction with the deployer key = %v, want ErrNotSender", err)
+	}
+}
+
+func TestHarnessWithoutDex(t *testing.T) {
+	h := newHarness(t, TokenConfig{Symbol: "CAKE"})
+	if err := h.Helper.SetNetwork(&web3helper.EVMNetwork{Name: "bare", ChainID: ChainID.Uint64()}); err != nil {
+		t.Fatal(err)
+	}
+	token := h.Token("CAKE").Address.Hex()
+
+	if pair, err := h.Helper.GetPair(token); err == nil {
+		t.Fatalf("GetPair = %s without a dex", pair)
+	}
+	if txHash, err := h.Helper.Buy(h.Buyer, token, 0.1, h.BuyerKey); err == nil {
+		t.Fatalf("Buy sent %s without a dex", txHash)
+	}
+}
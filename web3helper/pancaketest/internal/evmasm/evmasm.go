@@ -0,0 +1,190 @@
+// Package evmasm assembles EVM bytecode from Yul-like expressions. It backs
+// the mock contracts of pancaketest, which cannot depend on a Solidity
+// compiler.
+//
+// Expressions evaluate their arguments right to left and leave one value on
+// the stack, like Yul: Op(vm.SUB, a, b) computes a - b. Programs keep no
+// values on the stack between statements, locals live in memory.
+package evmasm
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// Expr emits code pushing a single value, or nothing when used as a
+// statement through Program.Do.
+type Expr func(p *Program)
+
+// Program is a piece of bytecode under construction.
+type Program struct {
+	code   []byte
+	labels map[string]int
+	refs   map[int]string
+	data   []dataSection
+	count  int
+}
+
+type dataSection struct {
+	label string
+	bytes []byte
+}
+
+func New() *Program {
+	return &Program{
+		labels: make(map[string]int),
+		refs:   make(map[int]string),
+	}
+}
+
+// Op returns an expression running code on args, the first argument ending on
+// top of the stack.
+func Op(code vm.OpCode, args ...Expr) Expr {
+	return func(p *Program) {
+		for i := len(args) - 1; i >= 0; i-- {
+			args[i](p)
+		}
+		p.code = append(p.code, byte(code))
+	}
+}
+
+func Num(value uint64) Expr {
+	return Big(new(big.Int).SetUint64(value))
+}
+
+func Big(value *big.Int) Expr {
+	return Bytes(value.Bytes())
+}
+
+// Bytes pushes value, at most 32 bytes, as a big endian word.
+func Bytes(value []byte) Expr {
+	return func(p *Program) {
+		if len(value) > 32 {
+			panic(fmt.Sprintf("evmasm: push of %d bytes", len(value)))
+		}
+		if len(value) == 0 {
+			value = []byte{0}
+		}
+		p.code = append(p.code, byte(vm.PUSH1)+byte(len(value)-1))
+		p.code = append(p.code, value...)
+	}
+}
+
+// Label pushes the offset of a label or data section.
+func Label(name string) Expr {
+	return func(p *Program) {
+		p.code = append(p.code, byte(vm.PUSH2))
+		p.refs[len(p.code)] = name
+		p.code = append(p.code, 0, 0)
+	}
+}
+
+// Do emits statements.
+func (p *Program) Do(statements ...Expr) {
+	for _, statement := range statements {
+		statement(p)
+	}
+}
+
+// NewLabel returns a label name unique within the program.
+func (p *Program) NewLabel() string {
+	p.count++
+	return fmt.Sprintf("L%d", p.count)
+}
+
+// Mark places a jump destination named label at the current position.
+func (p *Program) Mark(label string) {
+	if _, ok := p.labels[label]; ok {
+		panic("evmasm: duplicate label " + label)
+	}
+	p.labels[label] = len(p.code)
+	p.code = append(p.code, byte(vm.JUMPDEST))
+}
+
+func (p *Program) Jump(label string) {
+	p.Do(Op(vm.JUMP, Label(label)))
+}
+
+func (p *Program) JumpIf(cond Expr, label string) {
+	p.Do(Op(vm.JUMPI, Label(label), cond))
+}
+
+func (p *Program) If(cond Expr, body func()) {
+	end := p.NewLabel()
+	p.JumpIf(Op(vm.ISZERO, cond), end)
+	body()
+	p.Mark(end)
+}
+
+func (p *Program) IfElse(cond Expr, then func(), otherwise func()) {
+	elseLabel, end := p.NewLabel(), p.NewLabel()
+	p.JumpIf(Op(vm.ISZERO, cond), elseLabel)
+	then()
+	p.Jump(end)
+	p.Mark(elseLabel)
+	otherwise()
+	p.Mark(end)
+}
+
+func (p *Program) While(cond Expr, body func()) {
+	start, end := p.NewLabel(), p.NewLabel()
+	p.Mark(start)
+	p.JumpIf(Op(vm.ISZERO, cond), end)
+	body()
+	p.Jump(start)
+	p.Mark(end)
+}
+
+// Data appends bytes after the code, Label(label) pushes their offset.
+func (p *Program) Data(label string, bytes []byte) {
+	p.data = append(p.data, dataSection{label: label, bytes: bytes})
+}
+
+// Assemble resolves labels and returns the bytecode followed by the data
+// sections.
+func (p *Program) Assemble() ([]byte, error) {
+	code := append([]byte(nil), p.code...)
+	labels := make(map[string]int, len(p.labels)+len(p.data))
+	for name, offset := range p.labels {
+		labels[name] = offset
+	}
+
+	// a trailing STOP keeps data from being executed
+	code = append(code, byte(vm.STOP))
+	for _, section := range p.data {
+		if _, ok := labels[section.label]; ok {
+			return nil, fmt.Errorf("duplicate label %s", section.label)
+		}
+		labels[section.label] = len(code)
+		code = append(code, section.bytes...)
+	}
+
+	for position, name := range p.refs {
+		offset, ok := labels[name]
+		if !ok {
+			return nil, fmt.Errorf("undefined label %s", name)
+		}
+		if offset > 0xffff {
+			return nil, fmt.Errorf("label %s out of range", name)
+		}
+		code[position] = byte(offset >> 8)
+		code[position+1] = byte(offset)
+	}
+	return code, nil
+}
+
+// Deployer returns init code running constructor, if any, then deploying
+// runtime.
+func Deployer(runtime []byte, constructor func(p *Program)) ([]byte, error) {
+	p := New()
+	if constructor != nil {
+		constructor(p)
+	}
+
+	p.Do(Op(vm.CODECOPY, Num(0), Label("runtime"), Num(uint64(len(runtime)))))
+	p.Do(Op(vm.RETURN, Num(0), Num(uint64(len(runtime)))))
+	p.Data("runtime", runtime)
+	return p.Assemble()
+}
@@ -0,0 +1,272 @@
+package pancaketest
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/nikola43/web3golanghelper/web3helper/pancaketest/internal/evmasm"
+)
+
+// Storage slots of the pair, after the liquidity token slots 0-2.
+const (
+	factorySlot   = 3
+	token0Slot    = 4
+	token1Slot    = 5
+	reserve0Slot  = 6
+	reserve1Slot  = 7
+	timestampSlot = 8
+)
+
+// Storage slots of the factory.
+const (
+	feeToSlot       = 0
+	feeToSetterSlot = 1
+	getPairSlot     = 2
+	allPairsSlot    = 3
+)
+
+const minimumLiquidity = 1000
+
+// swapFeePerMille is the fee of the pair, matching the 998/1000 of
+// PancakeLibrary.getAmountOut in contracts/PancakeTestnetContract.sol.
+const swapFeePerMille = 2
+
+// pairCode returns the init code of a PancakePair equivalent without price
+// oracles, protocol fees and flash swaps.
+func pairCode() ([]byte, error) {
+	c := &contract{}
+
+	erc20(c, "Pancake LPs", "Cake-LP", 18, plainTransfer)
+
+	token0, token1 := sload(num(token0Slot)), sload(num(token1Slot))
+	reserve0, reserve1 := sload(num(reserve0Slot)), sload(num(reserve1Slot))
+
+	update := func(p *evmasm.Program, balance0, balance1 expr) {
+		maxReserve := evmasm.Big(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 112), big.NewInt(1)))
+		require(p, iszero(or(gt(balance0, maxReserve), gt(balance1, maxReserve))), "OVERFLOW")
+		p.Do(
+			sstore(num(reserve0Slot), balance0),
+			sstore(num(reserve1Slot), balance1),
+			sstore(num(timestampSlot), mod(op(vm.TIMESTAMP), num(1<<32))),
+		)
+		emit(p, "Sync(uint112,uint112)", nil, balance0, balance1)
+	}
+
+	c.function("totalSupply()", func(p *evmasm.Program) {
+		ret(p, sload(num(totalSupplySlot)))
+	})
+	c.function("factory()", func(p *evmasm.Program) {
+		ret(p, sload(num(factorySlot)))
+	})
+	c.function("token0()", func(p *evmasm.Program) {
+		ret(p, token0)
+	})
+	c.function("token1()", func(p *evmasm.Program) {
+		ret(p, token1)
+	})
+	c.function("MINIMUM_LIQUIDITY()", func(p *evmasm.Program) {
+		ret(p, num(minimumLiquidity))
+	})
+	c.function("getReserves()", func(p *evmasm.Program) {
+		ret(p, reserve0, reserve1, sload(num(timestampSlot)))
+	})
+
+	c.function("initialize(address,address)", func(p *evmasm.Program) {
+		require(p, eq(caller(), sload(num(factorySlot))), "FORBIDDEN")
+		p.Do(
+			sstore(num(token0Slot), addressArg(0)),
+			sstore(num(token1Slot), addressArg(1)),
+		)
+	})
+
+	c.function("mint(address)", func(p *evmasm.Program) {
+		setLocal(p, 0, balanceOf(token0, address()))
+		setLocal(p, 1, balanceOf(token1, address()))
+		require(p, and(geq(local(0), reserve0), geq(local(1), reserve1)), "INSUFFICIENT_LIQUIDITY_MINTED")
+		setLocal(p, 2, sub(local(0), reserve0))
+		setLocal(p, 3, sub(local(1), reserve1))
+		setLocal(p, 4, sload(num(totalSupplySlot)))
+
+		p.IfElse(iszero(local(4)), func() {
+			setLocal(p, 6, mul(local(2), local(3)))
+			sqrt(p, 5, 6)
+			require(p, gt(local(5), num(minimumLiquidity)), "INSUFFICIENT_LIQUIDITY_MINTED")
+			setLocal(p, 5, sub(local(5), num(minimumLiquidity)))
+			mint(p, num(0), num(minimumLiquidity))
+		}, func() {
+			setLocal(p, 5, div(mul(local(2), local(4)), reserve0))
+			setLocal(p, 6, div(mul(local(3), local(4)), reserve1))
+			p.If(lt(local(6), local(5)), func() {
+				setLocal(p, 5, local(6))
+			})
+		})
+
+		require(p, local(5), "INSUFFICIENT_LIQUIDITY_MINTED")
+		mint(p, addressArg(0), local(5))
+		update(p, local(0), local(1))
+		emit(p, "Mint(address,uint256,uint256)", []expr{caller()}, local(2), local(3))
+		ret(p, local(5))
+	})
+
+	c.function("burn(address)", func(p *evmasm.Program) {
+		setLocal(p, 0, balanceOf(token0, address()))
+		setLocal(p, 1, balanceOf(token1, address()))
+		setLocal(p, 2, sload(balanceSlot(address())))
+		setLocal(p, 4, sload(num(totalSupplySlot)))
+		setLocal(p, 5, div(mul(local(2), local(0)), local(4)))
+		setLocal(p, 6, div(mul(local(2), local(1)), local(4)))
+		require(p, and(local(5), local(6)), "INSUFFICIENT_LIQUIDITY_BURNED")
+
+		burn(p, address(), local(2))
+		safeTransfer(p, token0, addressArg(0), local(5))
+		safeTransfer(p, token1, addressArg(0), local(6))
+
+		setLocal(p, 0, balanceOf(token0, address()))
+		setLocal(p, 1, balanceOf(token1, address()))
+		update(p, local(0), local(1))
+		emit(p, "Burn(address,uint256,uint256,address)", []expr{caller(), addressArg(0)}, local(5), local(6))
+		ret(p, local(5), local(6))
+	})
+
+	c.function("swap(uint256,uint256,address,bytes)", func(p *evmasm.Program) {
+		setLocal(p, 0, arg(0))
+		setLocal(p, 1, arg(1))
+		setLocal(p, 2, addressArg(2))
+		require(p, or(local(0), local(1)), "INSUFFICIENT_OUTPUT_AMOUNT")
+		require(p, and(lt(local(0), reserve0), lt(local(1), reserve1)), "INSUFFICIENT_LIQUIDITY")
+		require(p, iszero(or(eq(local(2), token0), eq(local(2), token1))), "INVALID_TO")
+		require(p, iszero(op(vm.CALLDATALOAD, add(num(4), arg(3)))), "FLASH_SWAPS_UNSUPPORTED")
+
+		p.If(local(0), func() {
+			safeTransfer(p, token0, local(2), local(0))
+		})
+		p.If(local(1), func() {
+			safeTransfer(p, token1, local(2), local(1))
+		})
+
+		setLocal(p, 3, balanceOf(token0, address()))
+		setLocal(p, 4, balanceOf(token1, address()))
+
+		// amountIn = balance - (reserve - amountOut), when positive
+		setLocal(p, 5, num(0))
+		p.If(gt(local(3), sub(reserve0, local(0))), func() {
+			setLocal(p, 5, sub(local(3), sub(reserve0, local(0))))
+		})
+		setLocal(p, 6, num(0))
+		p.If(gt(local(4), sub(reserve1, local(1))), func() {
+			setLocal(p, 6, sub(local(4), sub(reserve1, local(1))))
+		})
+		require(p, or(local(5), local(6)), "INSUFFICIENT_INPUT_AMOUNT")
+
+		setLocal(p, 7, sub(mul(local(3), num(1000)), mul(local(5), num(swapFeePerMille))))
+		setLocal(p, 8, sub(mul(local(4), num(1000)), mul(local(6), num(swapFeePerMille))))
+		require(p, geq(mul(local(7), local(8)), mul(mul(reserve0, reserve1), num(1000*1000))), "K")
+
+		update(p, local(3), local(4))
+		emit(p, "Swap(address,uint256,uint256,uint256,uint256,address)", []expr{caller(), local(2)}, local(5), local(6), local(0), local(1))
+	})
+
+	c.function("skim(address)", func(p *evmasm.Program) {
+		setLocal(p, 0, sub(balanceOf(token0, address()), reserve0))
+		setLocal(p, 1, sub(balanceOf(token1, address()), reserve1))
+		safeTransfer(p, token0, addressArg(0), local(0))
+		safeTransfer(p, token1, addressArg(0), local(1))
+	})
+	c.function("sync()", func(p *evmasm.Program) {
+		setLocal(p, 0, balanceOf(token0, address()))
+		setLocal(p, 1, balanceOf(token1, address()))
+		update(p, local(0), local(1))
+	})
+
+	runtime, err := c.assemble()
+	if err != nil {
+		return nil, err
+	}
+
+	return evmasm.Deployer(runtime, func(p *evmasm.Program) {
+		p.Do(sstore(num(factorySlot), caller()))
+	})
+}
+
+func pairSlot(tokenA, tokenB expr) expr {
+	return mapSlot(mapSlot(num(getPairSlot), tokenA), tokenB)
+}
+
+// factoryCode returns the runtime code of a PancakeFactory equivalent
+// deploying pairs with pairInitCode. Its feeToSetter is set in genesis.
+func factoryCode(pairInitCode []byte) ([]byte, error) {
+	c := &contract{data: []data{{label: "pair", bytes: pairInitCode}}}
+
+	// allPairs is a dynamic array, its elements start at keccak256(slot)
+	allPairsData := func(p *evmasm.Program) {
+		p.Do(mstore(num(0), num(allPairsSlot)))
+		op(vm.KECCAK256, num(0), num(0x20))(p)
+	}
+
+	c.function("feeTo()", func(p *evmasm.Program) {
+		ret(p, sload(num(feeToSlot)))
+	})
+	c.function("feeToSetter()", func(p *evmasm.Program) {
+		ret(p, sload(num(feeToSetterSlot)))
+	})
+	c.function("setFeeTo(address)", func(p *evmasm.Program) {
+		require(p, eq(caller(), sload(num(feeToSetterSlot))), "FORBIDDEN")
+		p.Do(sstore(num(feeToSlot), addressArg(0)))
+	})
+	c.function("setFeeToSetter(address)", func(p *evmasm.Program) {
+		require(p, eq(caller(), sload(num(feeToSetterSlot))), "FORBIDDEN")
+		p.Do(sstore(num(feeToSetterSlot), addressArg(0)))
+	})
+	c.function("INIT_CODE_PAIR_HASH()", func(p *evmasm.Program) {
+		ret(p, evmasm.Bytes(crypto.Keccak256(pairInitCode)))
+	})
+	c.function("getPair(address,address)", func(p *evmasm.Program) {
+		ret(p, sload(pairSlot(addressArg(0), addressArg(1))))
+	})
+	c.function("allPairsLength()", func(p *evmasm.Program) {
+		ret(p, sload(num(allPairsSlot)))
+	})
+	c.function("allPairs(uint256)", func(p *evmasm.Program) {
+		require(p, lt(arg(0), sload(num(allPairsSlot))), "INDEX_OUT_OF_RANGE")
+		ret(p, sload(add(allPairsData, arg(0))))
+	})
+
+	c.function("createPair(address,address)", func(p *evmasm.Program) {
+		require(p, iszero(eq(addressArg(0), addressArg(1))), "IDENTICAL_ADDRESSES")
+		p.IfElse(lt(addressArg(0), addressArg(1)), func() {
+			setLocal(p, 0, addressArg(0))
+			setLocal(p, 1, addressArg(1))
+		}, func() {
+			setLocal(p, 0, addressArg(1))
+			setLocal(p, 1, addressArg(0))
+		})
+		require(p, local(0), "ZERO_ADDRESS")
+		require(p, iszero(sload(pairSlot(local(0), local(1)))), "PAIR_EXISTS")
+
+		// salt = keccak256(abi.encodePacked(token0, token1))
+		p.Do(
+			mstore(num(0), shl(96, local(0))),
+			mstore(num(20), shl(96, local(1))),
+		)
+		setLocal(p, 2, op(vm.KECCAK256, num(0), num(40)))
+
+		size := num(uint64(len(pairInitCode)))
+		p.Do(op(vm.CODECOPY, num(0x400), evmasm.Label("pair"), size))
+		setLocal(p, 3, op(vm.CREATE2, num(0), num(0x400), size, local(2)))
+		require(p, local(3), "CREATE2_FAILED")
+		require(p, call(vm.CALL, local(3), num(0), "initialize(address,address)", local(0), local(1)), "INITIALIZE_FAILED")
+
+		p.Do(
+			sstore(pairSlot(local(0), local(1)), local(3)),
+			sstore(pairSlot(local(1), local(0)), local(3)),
+			sstore(add(allPairsData, sload(num(allPairsSlot))), local(3)),
+			sstore(num(allPairsSlot), add(sload(num(allPairsSlot)), num(1))),
+		)
+		emit(p, "PairCreated(address,address,address,uint256)", []expr{local(0), local(1)}, local(3), sload(num(allPairsSlot)))
+		ret(p, local(3))
+	})
+
+	return c.assemble()
+}
@@ -0,0 +1,166 @@
+package pancaketest
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/nikola43/web3golanghelper/contracts/bindings/ierc20"
+	pancakeFactory "github.com/nikola43/web3golanghelper/contracts/bindings/ipancakefactory"
+	pancakePair "github.com/nikola43/web3golanghelper/contracts/bindings/ipancakepair"
+)
+
+func TestFactoryCreatePair(t *testing.T) {
+	h := newHarness(t, TokenConfig{Symbol: "A"}, TokenConfig{Symbol: "B"})
+	a, b := h.Token("A").Address, h.Token("B").Address
+
+	factory, err := pancakeFactory.NewIPancakeFactory(h.Factory, h.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := factory.CreatePair(h.transactor(nil), a, b)
+	if err != nil {
+		t.Fatalf("createPair: %v", err)
+	}
+	if err := h.mine(tx); err != nil {
+		t.Fatal(err)
+	}
+
+	pair, err := factory.GetPair(&bind.CallOpts{}, a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reversed, err := factory.GetPair(&bind.CallOpts{}, b, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pair == (common.Address{}) || pair != reversed {
+		t.Fatalf("getPair = %s and %s, want the same pair", pair.Hex(), reversed.Hex())
+	}
+
+	if length, err := factory.AllPairsLength(&bind.CallOpts{}); err != nil || length.Cmp(big.NewInt(1)) != 0 {
+		t.Fatalf("allPairsLength = %v, %v, want 1", length, err)
+	}
+	if first, err := factory.AllPairs(&bind.CallOpts{}, big.NewInt(0)); err != nil || first != pair {
+		t.Fatalf("allPairs(0) = %s, %v, want %s", first.Hex(), err, pair.Hex())
+	}
+
+	token0, token1 := a, b
+	if bytes.Compare(b.Bytes(), a.Bytes()) < 0 {
+		token0, token1 = b, a
+	}
+	caller, err := pancakePair.NewIPancakePairCaller(pair, h.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := caller.Token0(&bind.CallOpts{}); err != nil || got != token0 {
+		t.Fatalf("token0 = %s, %v, want %s", got.Hex(), err, token0.Hex())
+	}
+	if got, err := caller.Token1(&bind.CallOpts{}); err != nil || got != token1 {
+		t.Fatalf("token1 = %s, %v, want %s", got.Hex(), err, token1.Hex())
+	}
+
+	_, err = factory.CreatePair(h.transactor(nil), b, a)
+	expectRevert(t, err, "PAIR_EXISTS")
+}
+
+func TestPairMintAndSwap(t *testing.T) {
+	h := newHarness(t, TokenConfig{Symbol: "A"}, TokenConfig{Symbol: "B"})
+	tokenA, tokenB := h.Token("A"), h.Token("B")
+
+	factory, err := pancakeFactory.NewIPancakeFactory(h.Factory, h.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := factory.CreatePair(h.transactor(nil), tokenA.Address, tokenB.Address)
+	if err != nil {
+		t.Fatalf("createPair: %v", err)
+	}
+	if err := h.mine(tx); err != nil {
+		t.Fatal(err)
+	}
+	pairAddress, err := factory.GetPair(&bind.CallOpts{}, tokenA.Address, tokenB.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair, err := pancakePair.NewIPancakePair(pairAddress, h.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// seed 1:1 liquidity
+	for _, token := range []*Token{tokenA, tokenB} {
+		if err := token.transact("transfer", pairAddress, ether(1000)); err != nil {
+			t.Fatalf("transfer %s: %v", token.Symbol, err)
+		}
+	}
+	if tx, err = pair.Mint(h.transactor(nil), h.Deployer); err != nil {
+		t.Fatalf("mint: %v", err)
+	}
+	if err := h.mine(tx); err != nil {
+		t.Fatal(err)
+	}
+
+	// sqrt(1000e18 * 1000e18) minus the locked minimum liquidity
+	liquidity := tokenBalance(t, h, pairAddress, h.Deployer)
+	if want := new(big.Int).Sub(ether(1000), big.NewInt(minimumLiquidity)); liquidity.Cmp(want) != 0 {
+		t.Fatalf("liquidity = %s, want %s", liquidity, want)
+	}
+
+	token0, err := pair.Token0(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	in, out := tokenA, tokenB
+	if token0 != in.Address {
+		in, out = tokenB, tokenA
+	}
+
+	// amountOut = amountIn * 998 * reserveOut / (reserveIn * 1000 + amountIn * 998)
+	amountIn := ether(10)
+	amountOut := new(big.Int).Mul(new(big.Int).Mul(amountIn, big.NewInt(998)), ether(1000))
+	amountOut.Div(amountOut, new(big.Int).Add(new(big.Int).Mul(ether(1000), big.NewInt(1000)), new(big.Int).Mul(amountIn, big.NewInt(998))))
+
+	if err := in.transact("transfer", pairAddress, amountIn); err != nil {
+		t.Fatal(err)
+	}
+
+	recipient := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+
+	_, err = pair.Swap(h.transactor(nil), big.NewInt(0), new(big.Int).Add(amountOut, big.NewInt(1)), recipient, nil)
+	expectRevert(t, err, "K")
+
+	if tx, err = pair.Swap(h.transactor(nil), big.NewInt(0), amountOut, recipient, nil); err != nil {
+		t.Fatalf("swap: %v", err)
+	}
+	if err := h.mine(tx); err != nil {
+		t.Fatal(err)
+	}
+
+	if balance := tokenBalance(t, h, out.Address, recipient); balance.Cmp(amountOut) != 0 {
+		t.Fatalf("swap output = %s, want %s", balance, amountOut)
+	}
+
+	reserves, err := pair.GetReserves(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := new(big.Int).Add(ether(1000), amountIn); reserves.Reserve0.Cmp(want) != 0 {
+		t.Fatalf("reserve0 = %s, want %s", reserves.Reserve0, want)
+	}
+	if want := new(big.Int).Sub(ether(1000), amountOut); reserves.Reserve1.Cmp(want) != 0 {
+		t.Fatalf("reserve1 = %s, want %s", reserves.Reserve1, want)
+	}
+
+	outToken, err := ierc20.NewIERC20Caller(out.Address, h.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if held, err := outToken.BalanceOf(&bind.CallOpts{}, pairAddress); err != nil || held.Cmp(reserves.Reserve1) != 0 {
+		t.Fatalf("pair holds %v, %v, want reserve1", held, err)
+	}
+}
@@ -0,0 +1,34 @@
+package pancaketest
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// pendingBackend is the backend of Helper, it streams the hashes of the
+// transactions sent through it so SubscribePendingTransactions runs offline.
+// Transactions sent to the simulated backend directly are not streamed.
+type pendingBackend struct {
+	*backends.SimulatedBackend
+
+	pendingFeed event.Feed
+}
+
+func (b *pendingBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := b.SimulatedBackend.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	// like a node, do not wait for slow subscribers
+	go b.pendingFeed.Send(tx.Hash())
+	return nil
+}
+
+// SubscribePendingTransactions implements web3helper.PendingTxBackend.
+func (b *pendingBackend) SubscribePendingTransactions(ctx context.Context, ch chan<- common.Hash) (ethereum.Subscription, error) {
+	return b.pendingFeed.Subscribe(ch), nil
+}
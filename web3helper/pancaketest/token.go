@@ -0,0 +1,191 @@
+package pancaketest
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/nikola43/web3golanghelper/web3helper/pancaketest/internal/evmasm"
+)
+
+// Storage slots of the ERC20 mocks and of the pair liquidity token.
+const (
+	totalSupplySlot = 0
+	balancesSlot    = 1
+	allowancesSlot  = 2
+	ownerSlot       = 3
+	blacklistSlot   = 4
+)
+
+// MockTokenABI is the ABI of the mock ERC20 tokens. Only the owner, the
+// deployer, can mint and blacklist.
+const MockTokenABI = `[
+	{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"allowance","stateMutability":"view","inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"approve","stateMutability":"nonpayable","inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"transferFrom","stateMutability":"nonpayable","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"owner","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"transferFeeBps","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"mint","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"setBlacklisted","stateMutability":"nonpayable","inputs":[{"name":"account","type":"address"},{"name":"blacklisted","type":"bool"}],"outputs":[]},
+	{"type":"function","name":"isBlacklisted","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Approval","anonymous":false,"inputs":[{"name":"owner","type":"address","indexed":true},{"name":"spender","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}
+]`
+
+func balanceSlot(account expr) expr {
+	return mapSlot(num(balancesSlot), account)
+}
+
+func allowanceSlot(owner, spender expr) expr {
+	return mapSlot(mapSlot(num(allowancesSlot), owner), spender)
+}
+
+// erc20 adds the standard ERC20 functions to c, moving balances with transfer.
+func erc20(c *contract, name, symbol string, decimals uint8, transfer func(p *evmasm.Program, from, to, amount expr)) {
+	c.function("name()", func(p *evmasm.Program) {
+		retString(p, name)
+	})
+	c.function("symbol()", func(p *evmasm.Program) {
+		retString(p, symbol)
+	})
+	c.function("decimals()", func(p *evmasm.Program) {
+		ret(p, num(uint64(decimals)))
+	})
+	c.function("balanceOf(address)", func(p *evmasm.Program) {
+		ret(p, sload(balanceSlot(addressArg(0))))
+	})
+	c.function("allowance(address,address)", func(p *evmasm.Program) {
+		ret(p, sload(allowanceSlot(addressArg(0), addressArg(1))))
+	})
+	c.function("approve(address,uint256)", func(p *evmasm.Program) {
+		p.Do(sstore(allowanceSlot(caller(), addressArg(0)), arg(1)))
+		emit(p, "Approval(address,address,uint256)", []expr{caller(), addressArg(0)}, arg(1))
+		ret(p, num(1))
+	})
+	c.function("transfer(address,uint256)", func(p *evmasm.Program) {
+		transfer(p, caller(), addressArg(0), arg(1))
+		ret(p, num(1))
+	})
+	c.function("transferFrom(address,address,uint256)", func(p *evmasm.Program) {
+		setLocal(p, 12, sload(allowanceSlot(addressArg(0), caller())))
+		p.If(iszero(eq(local(12), evmasm.Big(maxUint256))), func() {
+			require(p, geq(local(12), arg(2)), "INSUFFICIENT_ALLOWANCE")
+			p.Do(sstore(allowanceSlot(addressArg(0), caller()), sub(local(12), arg(2))))
+		})
+		transfer(p, addressArg(0), addressArg(1), arg(2))
+		ret(p, num(1))
+	})
+}
+
+// debit takes amount from the balance of account.
+func debit(p *evmasm.Program, account, amount expr) {
+	setLocal(p, 14, sload(balanceSlot(account)))
+	require(p, geq(local(14), amount), "INSUFFICIENT_BALANCE")
+	p.Do(sstore(balanceSlot(account), sub(local(14), amount)))
+}
+
+func credit(p *evmasm.Program, account, amount expr) {
+	p.Do(sstore(balanceSlot(account), add(sload(balanceSlot(account)), amount)))
+}
+
+// plainTransfer moves balances without fees or restrictions.
+func plainTransfer(p *evmasm.Program, from, to, amount expr) {
+	debit(p, from, amount)
+	credit(p, to, amount)
+	emit(p, "Transfer(address,address,uint256)", []expr{from, to}, amount)
+}
+
+func mint(p *evmasm.Program, to, amount expr) {
+	p.Do(sstore(num(totalSupplySlot), add(sload(num(totalSupplySlot)), amount)))
+	credit(p, to, amount)
+	emit(p, "Transfer(address,address,uint256)", []expr{num(0), to}, amount)
+}
+
+func burn(p *evmasm.Program, from, amount expr) {
+	debit(p, from, amount)
+	p.Do(sstore(num(totalSupplySlot), sub(sload(num(totalSupplySlot)), amount)))
+	emit(p, "Transfer(address,address,uint256)", []expr{from, num(0)}, amount)
+}
+
+// tokenCode returns the init code of a mock ERC20 minting supply to its
+// deployer. Transfers burn feeBps basis points of the amount and revert when
+// either side is blacklisted.
+func tokenCode(name, symbol string, decimals uint8, supply *big.Int, feeBps uint64) ([]byte, error) {
+	c := &contract{}
+
+	erc20(c, name, symbol, decimals, func(p *evmasm.Program, from, to, amount expr) {
+		require(p, iszero(or(sload(mapSlot(num(blacklistSlot), from)), sload(mapSlot(num(blacklistSlot), to)))), "BLACKLISTED")
+		if feeBps == 0 {
+			plainTransfer(p, from, to, amount)
+			return
+		}
+
+		setLocal(p, 13, div(mul(amount, num(feeBps)), num(10000)))
+		burn(p, from, local(13))
+		plainTransfer(p, from, to, sub(amount, local(13)))
+	})
+
+	c.function("totalSupply()", func(p *evmasm.Program) {
+		ret(p, sload(num(totalSupplySlot)))
+	})
+	c.function("owner()", func(p *evmasm.Program) {
+		ret(p, sload(num(ownerSlot)))
+	})
+	c.function("transferFeeBps()", func(p *evmasm.Program) {
+		ret(p, num(feeBps))
+	})
+	c.function("mint(address,uint256)", func(p *evmasm.Program) {
+		require(p, eq(caller(), sload(num(ownerSlot))), "FORBIDDEN")
+		mint(p, addressArg(0), arg(1))
+	})
+	c.function("setBlacklisted(address,bool)", func(p *evmasm.Program) {
+		require(p, eq(caller(), sload(num(ownerSlot))), "FORBIDDEN")
+		p.Do(sstore(mapSlot(num(blacklistSlot), addressArg(0)), iszero(iszero(arg(1)))))
+	})
+	c.function("isBlacklisted(address)", func(p *evmasm.Program) {
+		ret(p, sload(mapSlot(num(blacklistSlot), addressArg(0))))
+	})
+
+	runtime, err := c.assemble()
+	if err != nil {
+		return nil, err
+	}
+
+	return evmasm.Deployer(runtime, func(p *evmasm.Program) {
+		p.Do(sstore(num(ownerSlot), caller()))
+		if supply != nil && supply.Sign() > 0 {
+			mint(p, caller(), evmasm.Big(supply))
+		}
+	})
+}
+
+// wethCode returns the runtime code of a WETH9 equivalent.
+func wethCode() ([]byte, error) {
+	c := &contract{}
+
+	erc20(c, "Wrapped Ether", "WETH", 18, plainTransfer)
+
+	deposit := func(p *evmasm.Program) {
+		credit(p, caller(), op(vm.CALLVALUE))
+		emit(p, "Deposit(address,uint256)", []expr{caller()}, op(vm.CALLVALUE))
+	}
+	c.fallback = deposit
+
+	c.function("deposit()", deposit)
+	c.function("withdraw(uint256)", func(p *evmasm.Program) {
+		debit(p, caller(), arg(0))
+		require(p, op(vm.CALL, op(vm.GAS), caller(), arg(0), num(0), num(0), num(0), num(0)), "WITHDRAW_FAILED")
+		emit(p, "Withdrawal(address,uint256)", []expr{caller()}, arg(0))
+	})
+	c.function("totalSupply()", func(p *evmasm.Program) {
+		ret(p, op(vm.SELFBALANCE))
+	})
+
+	return c.assemble()
+}
@@ -0,0 +1,126 @@
+package pancaketest
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/nikola43/web3golanghelper/contracts/bindings/ierc20"
+	"github.com/nikola43/web3golanghelper/contracts/bindings/iweth"
+)
+
+func TestWETH(t *testing.T) {
+	h := newHarness(t)
+
+	weth, err := iweth.NewIWETHTransactor(h.WETH, h.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := weth.Deposit(h.transactor(ether(3)))
+	if err != nil {
+		t.Fatalf("deposit: %v", err)
+	}
+	if err := h.mine(tx); err != nil {
+		t.Fatal(err)
+	}
+	if balance := tokenBalance(t, h, h.WETH, h.Deployer); balance.Cmp(ether(3)) != 0 {
+		t.Fatalf("balance after deposit = %s, want %s", balance, ether(3))
+	}
+
+	tx, err = weth.Withdraw(h.transactor(nil), ether(1))
+	if err != nil {
+		t.Fatalf("withdraw: %v", err)
+	}
+	if err := h.mine(tx); err != nil {
+		t.Fatal(err)
+	}
+	if balance := tokenBalance(t, h, h.WETH, h.Deployer); balance.Cmp(ether(2)) != 0 {
+		t.Fatalf("balance after withdraw = %s, want %s", balance, ether(2))
+	}
+
+	supply, err := ierc20.NewIERC20Caller(h.WETH, h.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total, err := supply.TotalSupply(&bind.CallOpts{}); err != nil || total.Cmp(ether(2)) != 0 {
+		t.Fatalf("totalSupply = %v, %v, want the ETH held", total, err)
+	}
+
+	_, err = weth.Withdraw(h.transactor(nil), ether(5))
+	expectRevert(t, err, "execution reverted")
+}
+
+func TestTokenTransferFee(t *testing.T) {
+	h := newHarness(t, TokenConfig{Symbol: "FEE", TransferFeeBps: 100})
+	token := h.Token("FEE")
+
+	recipient := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	if err := token.transact("transfer", recipient, big.NewInt(10000)); err != nil {
+		t.Fatalf("transfer: %v", err)
+	}
+
+	if balance := tokenBalance(t, h, token.Address, recipient); balance.Cmp(big.NewInt(9900)) != 0 {
+		t.Fatalf("recipient balance = %s, want 9900 after the 1%% fee", balance)
+	}
+
+	caller, err := ierc20.NewIERC20Caller(token.Address, h.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	total, err := caller.TotalSupply(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := new(big.Int).Sub(token.Supply, big.NewInt(100)); total.Cmp(want) != 0 {
+		t.Fatalf("totalSupply = %s, want %s after burning the fee", total, want)
+	}
+}
+
+func TestTokenBlacklist(t *testing.T) {
+	h := newHarness(t, TokenConfig{Symbol: "BL"})
+	token := h.Token("BL")
+
+	if err := token.Mint(h.Buyer, big.NewInt(1000)); err != nil {
+		t.Fatalf("mint: %v", err)
+	}
+	if err := token.SetBlacklisted(h.Buyer, true); err != nil {
+		t.Fatalf("setBlacklisted: %v", err)
+	}
+
+	buyerToken, err := ierc20.NewIERC20Transactor(token.Address, h.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth, err := h.Helper.NewTransactor(context.Background(), h.BuyerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = buyerToken.Transfer(auth, h.Deployer, big.NewInt(1))
+	expectRevert(t, err, "BLACKLISTED")
+
+	err = token.transact("transfer", h.Buyer, big.NewInt(1))
+	expectRevert(t, err, "BLACKLISTED")
+}
+
+func TestTokenMintOnlyOwner(t *testing.T) {
+	h := newHarness(t, TokenConfig{Symbol: "OWN"})
+	token := h.Token("OWN")
+
+	key, err := crypto.HexToECDSA(h.BuyerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth, err := bind.NewKeyedTransactorWithChainID(key, ChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = token.contract.Transact(auth, "mint", h.Buyer, big.NewInt(1))
+	expectRevert(t, err, "FORBIDDEN")
+}
@@ -0,0 +1,202 @@
+package web3helper
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultBuckets are the histogram buckets of PrometheusSink, in seconds.
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// PrometheusSink keeps metrics in memory and serves them in the Prometheus
+// text exposition format. One sink can be shared by many helpers.
+type PrometheusSink struct {
+	mu         sync.Mutex
+	buckets    []float64
+	counters   map[string]map[string]*sample
+	gauges     map[string]map[string]*sample
+	histograms map[string]map[string]*histogram
+}
+
+type sample struct {
+	labels Labels
+	value  float64
+}
+
+type histogram struct {
+	labels Labels
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// NewPrometheusSink returns an empty sink, using DefaultBuckets when buckets is empty.
+func NewPrometheusSink(buckets ...float64) *PrometheusSink {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+
+	sorted := append([]float64(nil), buckets...)
+	sort.Float64s(sorted)
+
+	return &PrometheusSink{
+		buckets:    sorted,
+		counters:   make(map[string]map[string]*sample),
+		gauges:     make(map[string]map[string]*sample),
+		histograms: make(map[string]map[string]*histogram),
+	}
+}
+
+func (s *PrometheusSink) AddCounter(name string, labels Labels, delta float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sample(s.counters, name, labels).value += delta
+}
+
+func (s *PrometheusSink) SetGauge(name string, labels Labels, value float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sample(s.gauges, name, labels).value = value
+}
+
+func (s *PrometheusSink) Observe(name string, labels Labels, value float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	series, ok := s.histograms[name]
+	if !ok {
+		series = make(map[string]*histogram)
+		s.histograms[name] = series
+	}
+
+	key := formatLabels(labels)
+	h, ok := series[key]
+	if !ok {
+		h = &histogram{labels: copyLabels(labels), counts: make([]uint64, len(s.buckets))}
+		series[key] = h
+	}
+
+	for i, bucket := range s.buckets {
+		if value <= bucket {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += value
+}
+
+func (s *PrometheusSink) sample(metrics map[string]map[string]*sample, name string, labels Labels) *sample {
+	series, ok := metrics[name]
+	if !ok {
+		series = make(map[string]*sample)
+		metrics[name] = series
+	}
+
+	key := formatLabels(labels)
+	value, ok := series[key]
+	if !ok {
+		value = &sample{labels: copyLabels(labels)}
+		series[key] = value
+	}
+	return value
+}
+
+// WriteTo writes every metric in the text exposition format, sorted by name
+// and labels.
+func (s *PrometheusSink) WriteTo(out io.Writer) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	buf := &strings.Builder{}
+
+	writeSamples := func(metrics map[string]map[string]*sample, metricType string) {
+		for _, name := range sortedKeys(metrics) {
+			fmt.Fprintf(buf, "# TYPE %s %s\n", name, metricType)
+			for _, key := range sortedKeys(metrics[name]) {
+				fmt.Fprintf(buf, "%s%s %s\n", name, key, formatFloat(metrics[name][key].value))
+			}
+		}
+	}
+
+	writeSamples(s.counters, "counter")
+	writeSamples(s.gauges, "gauge")
+
+	for _, name := range sortedKeys(s.histograms) {
+		fmt.Fprintf(buf, "# TYPE %s histogram\n", name)
+		for _, key := range sortedKeys(s.histograms[name]) {
+			h := s.histograms[name][key]
+			for i, bucket := range s.buckets {
+				fmt.Fprintf(buf, "%s_bucket%s %d\n", name, formatLabels(withLabel(h.labels, "le", formatFloat(bucket))), h.counts[i])
+			}
+			fmt.Fprintf(buf, "%s_bucket%s %d\n", name, formatLabels(withLabel(h.labels, "le", "+Inf")), h.count)
+			fmt.Fprintf(buf, "%s_sum%s %s\n", name, key, formatFloat(h.sum))
+			fmt.Fprintf(buf, "%s_count%s %d\n", name, key, h.count)
+		}
+	}
+
+	n, err := io.WriteString(out, buf.String())
+	return int64(n), err
+}
+
+// ServeHTTP serves the metrics to a Prometheus scraper.
+func (s *PrometheusSink) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	rw.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = s.WriteTo(rw)
+}
+
+// formatLabels returns {a="1",b="2"} with sorted names, empty without labels.
+func formatLabels(labels Labels) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		pairs = append(pairs, name+"="+strconv.Quote(labels[name]))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func copyLabels(labels Labels) Labels {
+	copied := make(Labels, len(labels))
+	for name, value := range labels {
+		copied[name] = value
+	}
+	return copied
+}
+
+func withLabel(labels Labels, name, value string) Labels {
+	extended := copyLabels(labels)
+	extended[name] = value
+	return extended
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatFloat(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,268 @@
+package web3helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+var ErrUnknownNetwork = errors.New("unknown network")
+
+// NetworkRegistry indexes networks by lower-cased name and by chain ID.
+type NetworkRegistry struct {
+	mu        sync.RWMutex
+	byName    map[string]*EVMNetwork
+	byChainID map[uint64]*EVMNetwork
+}
+
+func NewNetworkRegistry(networks ...*EVMNetwork) (*NetworkRegistry, error) {
+	registry := &NetworkRegistry{
+		byName:    make(map[string]*EVMNetwork),
+		byChainID: make(map[uint64]*EVMNetwork),
+	}
+
+	for _, network := range networks {
+		if err := registry.Register(network); err != nil {
+			return nil, err
+		}
+	}
+
+	return registry, nil
+}
+
+// DefaultNetworkRegistry returns a registry holding the built-in networks.
+func DefaultNetworkRegistry() *NetworkRegistry {
+	registry, err := NewNetworkRegistry(
+		AvalancheMainnet,
+		AvalancheFujiTesnet,
+		BinanceSmartChainMainnet,
+		BinanceSmartChainTestnet,
+	)
+	if err != nil {
+		panic(err)
+	}
+	return registry
+}
+
+// Register adds network, replacing any network with the same name or chain ID.
+func (r *NetworkRegistry) Register(network *EVMNetwork) error {
+	if network.Name == "" {
+		return errors.New("network name is required")
+	}
+	if network.ChainID == 0 {
+		return fmt.Errorf("network %s: chain id is required", network.Name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if previous, ok := r.byChainID[network.ChainID]; ok {
+		delete(r.byName, strings.ToLower(previous.Name))
+	}
+	if previous, ok := r.byName[strings.ToLower(network.Name)]; ok {
+		delete(r.byChainID, previous.ChainID)
+	}
+
+	r.byName[strings.ToLower(network.Name)] = network
+	r.byChainID[network.ChainID] = network
+	return nil
+}
+
+func (r *NetworkRegistry) ByName(name string) (*EVMNetwork, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	network, ok := r.byName[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownNetwork, name)
+	}
+	return network, nil
+}
+
+func (r *NetworkRegistry) ByChainID(chainID uint64) (*EVMNetwork, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	network, ok := r.byChainID[chainID]
+	if !ok {
+		return nil, fmt.Errorf("%w: chain id %d", ErrUnknownNetwork, chainID)
+	}
+	return network, nil
+}
+
+// Networks returns every registered network sorted by chain ID.
+func (r *NetworkRegistry) Networks() []*EVMNetwork {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	networks := make([]*EVMNetwork, 0, len(r.byChainID))
+	for _, network := range r.byChainID {
+		networks = append(networks, network)
+	}
+	sort.Slice(networks, func(i, j int) bool {
+		return networks[i].ChainID < networks[j].ChainID
+	})
+	return networks
+}
+
+// LoadFile registers the networks of a .json, .yaml or .yml file.
+func (r *NetworkRegistry) LoadFile(path string) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	networks, err := ParseNetworks(content, filepath.Ext(path))
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	for _, network := range networks {
+		if err := r.Register(network); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+type networksFile struct {
+	Networks []*EVMNetwork `json:"networks" yaml:"networks"`
+}
+
+// ParseNetworks decodes a networks file, substituting ${ENV} references in its
+// string values. format is the file extension: ".json", ".yaml" or ".yml".
+func ParseNetworks(content []byte, format string) ([]*EVMNetwork, error) {
+	var file networksFile
+	var err error
+	switch strings.ToLower(format) {
+	case ".json":
+		err = decodeJSONNetworks(content, &file)
+	case ".yaml", ".yml":
+		err = decodeYAMLNetworks(content, &file)
+	default:
+		return nil, fmt.Errorf("unsupported networks file format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return file.Networks, nil
+}
+
+func decodeJSONNetworks(content []byte, file *networksFile) error {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	var tree interface{}
+	if err := decoder.Decode(&tree); err != nil {
+		return err
+	}
+	tree, err := expandJSON(tree)
+	if err != nil {
+		return err
+	}
+
+	expanded, err := json.Marshal(tree)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(expanded, file)
+}
+
+// expandJSON substitutes the references of the strings of a decoded JSON value.
+func expandJSON(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case string:
+		return ExpandEnv(value)
+	case []interface{}:
+		for i := range value {
+			expanded, err := expandJSON(value[i])
+			if err != nil {
+				return nil, err
+			}
+			value[i] = expanded
+		}
+	case map[string]interface{}:
+		for key := range value {
+			expanded, err := expandJSON(value[key])
+			if err != nil {
+				return nil, err
+			}
+			value[key] = expanded
+		}
+	}
+	return value, nil
+}
+
+func decodeYAMLNetworks(content []byte, file *networksFile) error {
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil {
+		return err
+	}
+	if root.Kind == 0 {
+		return nil
+	}
+
+	if err := expandYAML(&root); err != nil {
+		return err
+	}
+	return root.Decode(file)
+}
+
+// expandYAML substitutes the references of the string scalars under node.
+// Comments are not part of the values and are left alone.
+func expandYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode && node.ShortTag() == "!!str" {
+		expanded, err := ExpandEnv(node.Value)
+		if err != nil {
+			return err
+		}
+		if expanded != node.Value {
+			node.Value = expanded
+			// resolve plain scalars again, chainId: ${CHAIN_ID} is a number
+			if node.Style == 0 {
+				node.Tag = ""
+			}
+		}
+		return nil
+	}
+
+	for _, child := range node.Content {
+		if err := expandYAML(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var envReference = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// ExpandEnv replaces ${NAME} with the value of the environment variable NAME.
+// Unset variables are reported instead of silently expanding to "".
+func ExpandEnv(s string) (string, error) {
+	missing := make([]string, 0)
+
+	expanded := envReference.ReplaceAllStringFunc(s, func(reference string) string {
+		name := envReference.FindStringSubmatch(reference)[1]
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return value
+	})
+
+	if len(missing) > 0 {
+		return "", fmt.Errorf("unset environment variables: %s", strings.Join(missing, ", "))
+	}
+	return expanded, nil
+}
@@ -0,0 +1,399 @@
+package web3helper
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var ErrNotIncluded = errors.New("transactions not included before their last block")
+
+// RelaySignatureHeader carries the signature of the auth key over the request
+// body, as Flashbots relays expect it.
+const RelaySignatureHeader = "X-Flashbots-Signature"
+
+// RelayConfig configures a PrivateRelay.
+type RelayConfig struct {
+	Url string
+
+	// AuthKey signs every request to identify the sender to the relay. It is
+	// a key of its own that needs no funds, a new one is generated when empty.
+	AuthKey string
+
+	// MaxBlocks is how many blocks private transactions stay valid, 25 by
+	// default.
+	MaxBlocks uint64
+
+	// PollInterval is how often inclusion is checked, 2s by default.
+	PollInterval time.Duration
+
+	HttpClient *http.Client
+}
+
+// PrivateRelay submits signed transactions and bundles to a relay speaking
+// eth_sendBundle and eth_sendPrivateTransaction, keeping them out of the
+// public mempool.
+type PrivateRelay struct {
+	// requestID comes first to stay aligned for atomic access on 32 bit
+	requestID uint64
+
+	config  RelayConfig
+	authKey *ecdsa.PrivateKey
+}
+
+func NewPrivateRelay(config RelayConfig) (*PrivateRelay, error) {
+	if config.Url == "" {
+		return nil, errors.New("relay url is required")
+	}
+	if config.MaxBlocks == 0 {
+		config.MaxBlocks = 25
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = 2 * time.Second
+	}
+	if config.HttpClient == nil {
+		config.HttpClient = http.DefaultClient
+	}
+
+	var (
+		authKey *ecdsa.PrivateKey
+		err     error
+	)
+	if config.AuthKey == "" {
+		authKey, err = crypto.GenerateKey()
+	} else {
+		authKey, err = crypto.HexToECDSA(strings.TrimPrefix(config.AuthKey, "0x"))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateRelay{config: config, authKey: authKey}, nil
+}
+
+// AuthAddress returns the address of the auth key, the identity of the
+// sender at the relay.
+func (r *PrivateRelay) AuthAddress() common.Address {
+	return crypto.PubkeyToAddress(r.authKey.PublicKey)
+}
+
+// Bundle is a list of transactions included together in BlockNumber or not
+// at all.
+type Bundle struct {
+	Txs         []*types.Transaction
+	BlockNumber uint64
+
+	// MinTimestamp and MaxTimestamp bound the block timestamp, 0 for none.
+	MinTimestamp uint64
+	MaxTimestamp uint64
+
+	// RevertingTxHashes are the transactions allowed to revert.
+	RevertingTxHashes []common.Hash
+}
+
+// SendBundle submits bundle and returns its hash at the relay.
+func (r *PrivateRelay) SendBundle(ctx context.Context, bundle *Bundle) (common.Hash, error) {
+	txs := make([]string, len(bundle.Txs))
+	for i, tx := range bundle.Txs {
+		raw, err := EncodeSignedTransaction(tx)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		txs[i] = raw
+	}
+
+	params := map[string]interface{}{
+		"txs":         txs,
+		"blockNumber": hexutil.EncodeUint64(bundle.BlockNumber),
+	}
+	if bundle.MinTimestamp > 0 {
+		params["minTimestamp"] = bundle.MinTimestamp
+	}
+	if bundle.MaxTimestamp > 0 {
+		params["maxTimestamp"] = bundle.MaxTimestamp
+	}
+	if len(bundle.RevertingTxHashes) > 0 {
+		params["revertingTxHashes"] = bundle.RevertingTxHashes
+	}
+
+	var result struct {
+		BundleHash common.Hash `json:"bundleHash"`
+	}
+	if err := r.call(ctx, &result, "eth_sendBundle", params); err != nil {
+		return common.Hash{}, err
+	}
+	return result.BundleHash, nil
+}
+
+// SendPrivateTransaction submits tx, valid up to maxBlockNumber.
+func (r *PrivateRelay) SendPrivateTransaction(ctx context.Context, tx *types.Transaction, maxBlockNumber uint64) (common.Hash, error) {
+	raw, err := EncodeSignedTransaction(tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	var txHash common.Hash
+	err = r.call(ctx, &txHash, "eth_sendPrivateTransaction", map[string]interface{}{
+		"tx":             raw,
+		"maxBlockNumber": hexutil.EncodeUint64(maxBlockNumber),
+	})
+	return txHash, err
+}
+
+// RelayError is a JSON-RPC error returned by the relay.
+type RelayError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *RelayError) Error() string {
+	return fmt.Sprintf("relay error %d: %s", e.Code, e.Message)
+}
+
+// call posts a JSON-RPC request signed with the auth key.
+func (r *PrivateRelay) call(ctx context.Context, result interface{}, method string, params ...interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      atomic.AddUint64(&r.requestID, 1),
+		"method":  method,
+		"params":  params,
+	})
+	if err != nil {
+		return err
+	}
+
+	signature, err := r.sign(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.config.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(RelaySignatureHeader, signature)
+
+	resp, err := r.config.HttpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Result json.RawMessage `json:"result"`
+		Error  *RelayError     `json:"error"`
+	}
+	if err := json.Unmarshal(content, &response); err != nil {
+		return fmt.Errorf("relay returned %s: %s", resp.Status, bytes.TrimSpace(content))
+	}
+	if response.Error != nil {
+		return response.Error
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("relay returned %s", resp.Status)
+	}
+
+	return json.Unmarshal(response.Result, result)
+}
+
+// sign returns the signature header of body: the auth address and its
+// personal signature of the hex keccak256 hash of body.
+func (r *PrivateRelay) sign(body []byte) (string, error) {
+	digest := hexutil.Encode(crypto.Keccak256(body))
+
+	signature, err := signHashWithKey(accounts.TextHash([]byte(digest)), r.authKey)
+	if err != nil {
+		return "", err
+	}
+
+	return r.AuthAddress().Hex() + ":" + hexutil.Encode(signature), nil
+}
+
+// SetPrivateRelay makes every send go to relay as a private transaction valid
+// for its MaxBlocks, nil sends to the public mempool again.
+func (w *Web3GolangHelper) SetPrivateRelay(relay *PrivateRelay) {
+	w.privateRelay = relay
+}
+
+// sendPrivateTransaction submits tx to the private relay and returns the last
+// block it is valid for, which WaitForPrivateTransaction waits up to.
+func (w *Web3GolangHelper) sendPrivateTransaction(ctx context.Context, tx *types.Transaction) (uint64, error) {
+	head, err := w.selectClient().HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	maxBlockNumber := head.Number.Uint64() + w.privateRelay.config.MaxBlocks
+	if _, err := w.privateRelay.SendPrivateTransaction(ctx, tx, maxBlockNumber); err != nil {
+		return 0, err
+	}
+	w.trackPrivateTx(tx.Hash(), maxBlockNumber, head.Number.Uint64())
+
+	w.log(InfoLogLevel, "private transaction sent", "txHash", tx.Hash(), "maxBlockNumber", maxBlockNumber)
+	return maxBlockNumber, nil
+}
+
+// trackPrivateTx remembers the last block of a private transaction, dropping
+// the transactions that expired before head.
+func (w *Web3GolangHelper) trackPrivateTx(txHash common.Hash, maxBlockNumber uint64, head uint64) {
+	w.privateTxsMu.Lock()
+	defer w.privateTxsMu.Unlock()
+
+	if w.privateTxs == nil {
+		w.privateTxs = make(map[common.Hash]uint64)
+	}
+	for hash, last := range w.privateTxs {
+		if last < head {
+			delete(w.privateTxs, hash)
+		}
+	}
+	w.privateTxs[txHash] = maxBlockNumber
+}
+
+// WaitForPrivateTransaction polls until a private transaction sent by the
+// helper is mined and returns its receipt, ErrNotIncluded once the last
+// block it was valid for passes.
+func (w *Web3GolangHelper) WaitForPrivateTransaction(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	w.privateTxsMu.Lock()
+	maxBlockNumber, ok := w.privateTxs[txHash]
+	w.privateTxsMu.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("transaction %s was not sent to the private relay or expired", txHash.Hex())
+	}
+
+	receipts, err := w.WaitForInclusion(ctx, []common.Hash{txHash}, maxBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	w.privateTxsMu.Lock()
+	delete(w.privateTxs, txHash)
+	w.privateTxsMu.Unlock()
+
+	return receipts[0], nil
+}
+
+// BundleSubmission is a bundle submitted for a range of blocks.
+type BundleSubmission struct {
+	TxHashes     []common.Hash
+	BundleHashes []common.Hash
+
+	FromBlock uint64
+	ToBlock   uint64
+}
+
+// SendBundle submits txs as a bundle to the private relay, once for each of
+// the next blocks blocks.
+func (w *Web3GolangHelper) SendBundle(ctx context.Context, txs []*types.Transaction, blocks uint64) (*BundleSubmission, error) {
+	if w.privateRelay == nil {
+		return nil, errors.New("no private relay set")
+	}
+	if blocks == 0 {
+		blocks = 1
+	}
+
+	head, err := w.selectClient().HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	submission := &BundleSubmission{
+		FromBlock: head.Number.Uint64() + 1,
+		ToBlock:   head.Number.Uint64() + blocks,
+	}
+	for _, tx := range txs {
+		submission.TxHashes = append(submission.TxHashes, tx.Hash())
+	}
+
+	for block := submission.FromBlock; block <= submission.ToBlock; block++ {
+		bundleHash, err := w.privateRelay.SendBundle(ctx, &Bundle{Txs: txs, BlockNumber: block})
+		if err != nil {
+			return nil, err
+		}
+		submission.BundleHashes = append(submission.BundleHashes, bundleHash)
+	}
+
+	w.log(InfoLogLevel, "bundle sent", "txs", len(txs), "fromBlock", submission.FromBlock, "toBlock", submission.ToBlock)
+	return submission, nil
+}
+
+// WaitForBundle polls until every transaction of submission is mined and
+// returns their receipts, ErrNotIncluded once its last block passes.
+func (w *Web3GolangHelper) WaitForBundle(ctx context.Context, submission *BundleSubmission) ([]*types.Receipt, error) {
+	return w.WaitForInclusion(ctx, submission.TxHashes, submission.ToBlock)
+}
+
+// WaitForInclusion polls until every transaction of txHashes is mined and
+// returns their receipts, ErrNotIncluded when a block after lastBlock is
+// mined without them. Private transactions sent by the helper stay valid
+// for the MaxBlocks of the relay after the head they were sent at.
+func (w *Web3GolangHelper) WaitForInclusion(ctx context.Context, txHashes []common.Hash, lastBlock uint64) ([]*types.Receipt, error) {
+	pollInterval := 2 * time.Second
+	if w.privateRelay != nil {
+		pollInterval = w.privateRelay.config.PollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	receipts := make([]*types.Receipt, len(txHashes))
+	for {
+		// the head is read first so a receipt mined in lastBlock is not missed
+		head, err := w.selectClient().HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		missing := 0
+		for i, txHash := range txHashes {
+			if receipts[i] != nil {
+				continue
+			}
+			receipt, err := w.selectClient().TransactionReceipt(ctx, txHash)
+			if err != nil && !errors.Is(err, ethereum.NotFound) {
+				return nil, err
+			}
+			if receipt == nil {
+				missing++
+				continue
+			}
+			receipts[i] = receipt
+		}
+
+		if missing == 0 {
+			return receipts, nil
+		}
+		if head.Number.Cmp(new(big.Int).SetUint64(lastBlock)) > 0 {
+			return nil, fmt.Errorf("%w: %d of %d missing after block %d", ErrNotIncluded, missing, len(txHashes), lastBlock)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,246 @@
+// Package relaytest serves a private relay in front of a simulated chain, so
+// web3helper.PrivateRelay submissions run end to end without a network.
+//
+//	relay := relaytest.NewServer(backend)
+//	defer relay.Close()
+//
+//	private, err := web3helper.NewPrivateRelay(web3helper.RelayConfig{Url: relay.URL})
+//	helper.SetPrivateRelay(private)
+//
+// Private transactions and bundles targeting the next block are mined right
+// away, unless SetDrop(true) was called. Requests without a valid signature
+// header are rejected.
+package relaytest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/nikola43/web3golanghelper/web3helper"
+)
+
+// Bundle is a bundle received by the relay.
+type Bundle struct {
+	Txs         []*types.Transaction
+	BlockNumber uint64
+
+	// Included is set when the bundle was mined.
+	Included bool
+}
+
+// Server is the stub relay. URL is its endpoint.
+type Server struct {
+	*httptest.Server
+
+	backend *backends.SimulatedBackend
+
+	mu         sync.Mutex
+	drop       bool
+	signers    []common.Address
+	bundles    []*Bundle
+	privateTxs []*types.Transaction
+}
+
+func NewServer(backend *backends.SimulatedBackend) *Server {
+	s := &Server{backend: backend}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// SetDrop makes the relay accept submissions without mining them, as when
+// no builder picks them up.
+func (s *Server) SetDrop(drop bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.drop = drop
+}
+
+// Signers returns the auth addresses of the accepted requests.
+func (s *Server) Signers() []common.Address {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]common.Address(nil), s.signers...)
+}
+
+// Bundles returns the bundles received.
+func (s *Server) Bundles() []*Bundle {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*Bundle(nil), s.bundles...)
+}
+
+// PrivateTransactions returns the private transactions received.
+func (s *Server) PrivateTransactions() []*types.Transaction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*types.Transaction(nil), s.privateTxs...)
+}
+
+type request struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var req request
+	if err := json.Unmarshal(body, &req); err != nil || len(req.Params) != 1 {
+		reply(w, http.StatusBadRequest, req.ID, nil, errors.New("invalid request"))
+		return
+	}
+
+	signer, err := verifySignature(r.Header.Get(web3helper.RelaySignatureHeader), body)
+	if err != nil {
+		reply(w, http.StatusForbidden, req.ID, nil, err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.signers = append(s.signers, signer)
+
+	var result interface{}
+	switch req.Method {
+	case "eth_sendBundle":
+		result, err = s.sendBundle(r.Context(), req.Params[0])
+	case "eth_sendPrivateTransaction":
+		result, err = s.sendPrivateTransaction(r.Context(), req.Params[0])
+	default:
+		err = fmt.Errorf("method %s not supported", req.Method)
+	}
+	reply(w, http.StatusOK, req.ID, result, err)
+}
+
+func (s *Server) sendBundle(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var args struct {
+		Txs         []hexutil.Bytes `json:"txs"`
+		BlockNumber hexutil.Uint64  `json:"blockNumber"`
+	}
+	if err := json.Unmarshal(params, &args); err != nil {
+		return nil, err
+	}
+
+	bundle := &Bundle{BlockNumber: uint64(args.BlockNumber)}
+	hashes := make([]byte, 0, 32*len(args.Txs))
+	for _, raw := range args.Txs {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(raw); err != nil {
+			return nil, err
+		}
+		bundle.Txs = append(bundle.Txs, tx)
+		hashes = append(hashes, tx.Hash().Bytes()...)
+	}
+	s.bundles = append(s.bundles, bundle)
+
+	head, err := s.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// bundles that no longer apply, e.g. resubmitted for a later block after
+	// being mined, are dropped like builders do
+	if !s.drop && bundle.BlockNumber == head.Number.Uint64()+1 {
+		bundle.Included = s.mine(ctx, bundle.Txs...) == nil
+	}
+
+	return map[string]interface{}{"bundleHash": crypto.Keccak256Hash(hashes)}, nil
+}
+
+func (s *Server) sendPrivateTransaction(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var args struct {
+		Tx hexutil.Bytes `json:"tx"`
+	}
+	if err := json.Unmarshal(params, &args); err != nil {
+		return nil, err
+	}
+
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(args.Tx); err != nil {
+		return nil, err
+	}
+	s.privateTxs = append(s.privateTxs, tx)
+
+	if !s.drop {
+		if err := s.mine(ctx, tx); err != nil {
+			return nil, err
+		}
+	}
+	return tx.Hash(), nil
+}
+
+// mine sends txs to the simulated chain and mines them in one block.
+func (s *Server) mine(ctx context.Context, txs ...*types.Transaction) error {
+	for _, tx := range txs {
+		if err := s.backend.SendTransaction(ctx, tx); err != nil {
+			s.backend.Rollback()
+			return err
+		}
+	}
+	s.backend.Commit()
+	return nil
+}
+
+// verifySignature checks a signature header of body and returns the signer.
+func verifySignature(header string, body []byte) (common.Address, error) {
+	parts := strings.Split(header, ":")
+	if len(parts) != 2 || !common.IsHexAddress(parts[0]) {
+		return common.Address{}, errors.New("missing or malformed signature header")
+	}
+
+	signature, err := hexutil.Decode(parts[1])
+	if err != nil || len(signature) != crypto.SignatureLength {
+		return common.Address{}, errors.New("malformed signature")
+	}
+	if signature[crypto.RecoveryIDOffset] >= 27 {
+		signature[crypto.RecoveryIDOffset] -= 27
+	}
+
+	digest := hexutil.Encode(crypto.Keccak256(body))
+	publicKey, err := crypto.SigToPub(accounts.TextHash([]byte(digest)), signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	signer := crypto.PubkeyToAddress(*publicKey)
+	if signer != common.HexToAddress(parts[0]) {
+		return common.Address{}, errors.New("signature does not match the address")
+	}
+	return signer, nil
+}
+
+func reply(w http.ResponseWriter, status int, id json.RawMessage, result interface{}, err error) {
+	response := map[string]interface{}{"jsonrpc": "2.0", "id": id}
+	if err != nil {
+		response["error"] = map[string]interface{}{"code": -32000, "message": err.Error()}
+	} else {
+		response["result"] = result
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,239 @@
+package relaytest_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/nikola43/web3golanghelper/web3helper"
+	"github.com/nikola43/web3golanghelper/web3helper/relaytest"
+)
+
+var chainID = big.NewInt(1337)
+
+type env struct {
+	backend *backends.SimulatedBackend
+	relay   *relaytest.Server
+	private *web3helper.PrivateRelay
+	helper  *web3helper.Web3GolangHelper
+
+	key       *ecdsa.PrivateKey
+	from      common.Address
+	to        common.Address
+	nextNonce uint64
+}
+
+func newEnv(t *testing.T) *env {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		from: {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(1e18))},
+	}, 30000000)
+	t.Cleanup(func() { backend.Close() })
+
+	relay := relaytest.NewServer(backend)
+	t.Cleanup(relay.Close)
+
+	private, err := web3helper.NewPrivateRelay(web3helper.RelayConfig{
+		Url:          relay.URL,
+		MaxBlocks:    2,
+		PollInterval: 10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	helper, err := web3helper.NewWeb3GolangHelperFromBackend(backend, chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	helper.SetPrivateRelay(private)
+
+	return &env{
+		backend: backend,
+		relay:   relay,
+		private: private,
+		helper:  helper,
+		key:     key,
+		from:    from,
+		to:      common.HexToAddress("0x00000000000000000000000000000000000000cc"),
+	}
+}
+
+// transfer returns a signed transfer of 1 wei at the next nonce.
+func (e *env) transfer(t *testing.T) *types.Transaction {
+	t.Helper()
+
+	gasPrice, err := e.backend.SuggestGasPrice(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := types.SignTx(types.NewTransaction(e.nextNonce, e.to, big.NewInt(1), 21000, gasPrice, nil), types.LatestSignerForChainID(chainID), e.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.nextNonce++
+	return tx
+}
+
+func (e *env) pk() string {
+	return common.Bytes2Hex(crypto.FromECDSA(e.key))
+}
+
+func TestSendBundle(t *testing.T) {
+	e := newEnv(t)
+	ctx := context.Background()
+
+	txs := []*types.Transaction{e.transfer(t), e.transfer(t)}
+	submission, err := e.helper.SendBundle(ctx, txs, 3)
+	if err != nil {
+		t.Fatalf("SendBundle: %v", err)
+	}
+	if submission.FromBlock != 1 || submission.ToBlock != 3 || len(submission.BundleHashes) != 3 {
+		t.Fatalf("submission covers blocks %d-%d with %d bundles, want 1-3 with 3", submission.FromBlock, submission.ToBlock, len(submission.BundleHashes))
+	}
+
+	receipts, err := e.helper.WaitForBundle(ctx, submission)
+	if err != nil {
+		t.Fatalf("WaitForBundle: %v", err)
+	}
+	for i, receipt := range receipts {
+		if receipt.TxHash != txs[i].Hash() || receipt.Status != types.ReceiptStatusSuccessful {
+			t.Fatalf("receipt %d = %s status %d, want %s mined", i, receipt.TxHash.Hex(), receipt.Status, txs[i].Hash().Hex())
+		}
+		if receipt.BlockNumber.Cmp(receipts[0].BlockNumber) != 0 {
+			t.Fatal("bundle split across blocks")
+		}
+	}
+
+	bundles := e.relay.Bundles()
+	if len(bundles) != 3 || !bundles[0].Included || bundles[1].Included {
+		t.Fatalf("relay got %d bundles, want 3 with only the first included", len(bundles))
+	}
+	for _, signer := range e.relay.Signers() {
+		if signer != e.private.AuthAddress() {
+			t.Fatalf("request signed by %s, want %s", signer.Hex(), e.private.AuthAddress().Hex())
+		}
+	}
+}
+
+func TestDroppedBundleNotIncluded(t *testing.T) {
+	e := newEnv(t)
+	ctx := context.Background()
+	e.relay.SetDrop(true)
+
+	submission, err := e.helper.SendBundle(ctx, []*types.Transaction{e.transfer(t)}, 1)
+	if err != nil {
+		t.Fatalf("SendBundle: %v", err)
+	}
+
+	// mine past the last block of the bundle without it
+	e.backend.Commit()
+	e.backend.Commit()
+
+	if _, err := e.helper.WaitForBundle(ctx, submission); !errors.Is(err, web3helper.ErrNotIncluded) {
+		t.Fatalf("WaitForBundle = %v, want ErrNotIncluded", err)
+	}
+}
+
+func TestPrivateTransaction(t *testing.T) {
+	e := newEnv(t)
+	ctx := context.Background()
+
+	txHash, _, err := e.helper.SendEth(e.from, e.to.Hex(), "0.01", e.pk())
+	if err != nil {
+		t.Fatalf("SendEth: %v", err)
+	}
+	if len(e.relay.PrivateTransactions()) != 1 {
+		t.Fatal("transaction not sent to the relay")
+	}
+
+	receipt, err := e.helper.WaitForPrivateTransaction(ctx, common.HexToHash(txHash))
+	if err != nil {
+		t.Fatalf("WaitForPrivateTransaction: %v", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("private transaction %s reverted", txHash)
+	}
+}
+
+func TestDroppedPrivateTransactionNotIncluded(t *testing.T) {
+	e := newEnv(t)
+	ctx := context.Background()
+	e.relay.SetDrop(true)
+
+	txHash, _, err := e.helper.SendEth(e.from, e.to.Hex(), "0.01", e.pk())
+	if err != nil {
+		t.Fatalf("SendEth: %v", err)
+	}
+
+	// MaxBlocks is 2, the transaction is valid up to block 2
+	for i := 0; i < 3; i++ {
+		e.backend.Commit()
+	}
+
+	if _, err := e.helper.WaitForPrivateTransaction(ctx, common.HexToHash(txHash)); !errors.Is(err, web3helper.ErrNotIncluded) {
+		t.Fatalf("WaitForPrivateTransaction = %v, want ErrNotIncluded", err)
+	}
+}
+
+func TestRejectsBadSignature(t *testing.T) {
+	e := newEnv(t)
+	body := []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_sendBundle","params":[{"txs":[],"blockNumber":"0x1"}]}`)
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := crypto.Sign(crypto.Keccak256([]byte("another body")), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	headers := map[string]string{
+		"missing":   "",
+		"malformed": "not a signature",
+		"mismatch":  crypto.PubkeyToAddress(key.PublicKey).Hex() + ":0x" + common.Bytes2Hex(signature),
+	}
+	for name, header := range headers {
+		req, err := http.NewRequest(http.MethodPost, e.relay.URL, bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if header != "" {
+			req.Header.Set(web3helper.RelaySignatureHeader, header)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusForbidden {
+			t.Errorf("%s signature: status %d, want %d", name, resp.StatusCode, http.StatusForbidden)
+		}
+	}
+
+	if len(e.relay.Bundles()) != 0 || len(e.relay.Signers()) != 0 {
+		t.Fatal("relay accepted an unsigned request")
+	}
+}
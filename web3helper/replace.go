@@ -0,0 +1,204 @@
+package web3helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// MinReplacementBumpPercent is the fee increase nodes require before they
+// accept a transaction replacing a pending one.
+const MinReplacementBumpPercent = 10
+
+var (
+	ErrTransactionNotPending = errors.New("transaction is not pending")
+	ErrNotSender             = errors.New("key is not the sender of the transaction")
+)
+
+type TxStatus struct {
+	Hash    common.Hash
+	Found   bool
+	Pending bool
+	Tx      *types.Transaction
+	Receipt *types.Receipt
+
+	// Revert is the replayed reason of a reverted transaction.
+	Revert *RevertError
+}
+
+// Succeeded reports whether the transaction was mined without reverting.
+func (s *TxStatus) Succeeded() bool {
+	return s.Receipt != nil && s.Receipt.Status == types.ReceiptStatusSuccessful
+}
+
+func (w *Web3GolangHelper) TransactionStatus(ctx context.Context, txHash string) (*TxStatus, error) {
+	status := &TxStatus{Hash: common.HexToHash(txHash)}
+
+	tx, pending, err := w.selectClient().TransactionByHash(ctx, status.Hash)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return status, nil
+		}
+		return nil, err
+	}
+
+	status.Found = true
+	status.Pending = pending
+	status.Tx = tx
+
+	if !pending {
+		receipt, err := w.selectClient().TransactionReceipt(ctx, status.Hash)
+		if err != nil {
+			return nil, err
+		}
+		status.Receipt = receipt
+		w.recordReceipt(ctx, receipt)
+
+		if receipt.Status == types.ReceiptStatusFailed {
+			if status.Revert, err = w.replayRevert(ctx, tx, receipt); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return status, nil
+}
+
+// SpeedUpTransaction resends a pending transaction with the same nonce and
+// payload, paying bumpPercent more fees.
+func (w *Web3GolangHelper) SpeedUpTransaction(ctx context.Context, txHash string, bumpPercent int64, pk string) (*types.Transaction, error) {
+	return w.replaceTransaction(ctx, txHash, bumpPercent, pk, false)
+}
+
+// CancelPendingTransaction replaces a pending transaction by an empty transfer
+// to the sender itself, paying bumpPercent more fees.
+func (w *Web3GolangHelper) CancelPendingTransaction(ctx context.Context, txHash string, bumpPercent int64, pk string) (*types.Transaction, error) {
+	return w.replaceTransaction(ctx, txHash, bumpPercent, pk, true)
+}
+
+func (w *Web3GolangHelper) replaceTransaction(ctx context.Context, txHash string, bumpPercent int64, pk string, cancel bool) (*types.Transaction, error) {
+
+	if bumpPercent < MinReplacementBumpPercent {
+		bumpPercent = MinReplacementBumpPercent
+	}
+
+	status, err := w.TransactionStatus(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if !status.Pending {
+		return nil, ErrTransactionNotPending
+	}
+	original := status.Tx
+
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(pk, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	fromAddress := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	chainID, err := w.cachedChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// signed by another key, the replacement would spend that key's nonce
+	// and leave the original pending
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), original)
+	if err != nil {
+		return nil, err
+	}
+	if sender != fromAddress {
+		return nil, fmt.Errorf("%w: key of %s, sent by %s", ErrNotSender, fromAddress.Hex(), sender.Hex())
+	}
+
+	to := original.To()
+	value := original.Value()
+	data := original.Data()
+	gasLimit := original.Gas()
+	accessList := original.AccessList()
+	if cancel {
+		to = &fromAddress
+		value = big.NewInt(0)
+		data = nil
+		gasLimit = params.TxGas
+		accessList = nil
+	}
+
+	var replacement *types.Transaction
+	if original.Type() == types.DynamicFeeTxType {
+		replacement = types.NewTx(&types.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      original.Nonce(),
+			GasTipCap:  bumpFee(original.GasTipCap(), bumpPercent),
+			GasFeeCap:  bumpFee(original.GasFeeCap(), bumpPercent),
+			Gas:        gasLimit,
+			To:         to,
+			Value:      value,
+			Data:       data,
+			AccessList: accessList,
+		})
+	} else {
+		gasPrice := bumpFee(original.GasPrice(), bumpPercent)
+
+		// the network price may have moved above the bumped one
+		suggested, err := w.suggestGasPrice(ctx)
+		if err == nil && suggested.Cmp(gasPrice) > 0 {
+			gasPrice = suggested
+		}
+
+		if accessList != nil {
+			replacement = types.NewTx(&types.AccessListTx{
+				ChainID:    chainID,
+				Nonce:      original.Nonce(),
+				GasPrice:   gasPrice,
+				Gas:        gasLimit,
+				To:         to,
+				Value:      value,
+				Data:       data,
+				AccessList: accessList,
+			})
+		} else {
+			replacement = types.NewTx(&types.LegacyTx{
+				Nonce:    original.Nonce(),
+				GasPrice: gasPrice,
+				Gas:      gasLimit,
+				To:       to,
+				Value:    value,
+				Data:     data,
+			})
+		}
+	}
+
+	signedTx, err := types.SignTx(replacement, types.LatestSignerForChainID(chainID), privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.sendTransaction(ctx, signedTx); err != nil {
+		return nil, err
+	}
+
+	w.recordTxReplaced(status.Hash)
+	w.notifyTxSent(signedTx)
+	return signedTx, nil
+}
+
+func bumpFee(fee *big.Int, bumpPercent int64) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(100+bumpPercent))
+	bumped.Div(bumped, big.NewInt(100))
+
+	// integer division may round a small fee back to itself
+	if bumped.Cmp(fee) <= 0 {
+		bumped.Add(fee, big.NewInt(1))
+	}
+	return bumped
+}
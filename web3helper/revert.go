@@ -0,0 +1,361 @@
+package web3helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var ErrExecutionReverted = errors.New("execution reverted")
+
+var (
+	errorStringSelector = [4]byte{0x08, 0xc3, 0x79, 0xa0}
+	panicSelector       = [4]byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+// panicReasons describes the Panic(uint256) codes emitted by solidity.
+var panicReasons = map[uint64]string{
+	0x00: "generic panic",
+	0x01: "assert failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to uninitialized function",
+}
+
+// RevertError is a call or transaction reverted by the EVM. Name is "Error"
+// for require messages, "Panic" for solidity panics, the error name for
+// custom errors of registered ABIs and empty when the data is unknown.
+type RevertError struct {
+	Name      string
+	Reason    string
+	Arguments []DecodedArgument
+	Data      []byte
+
+	// TxHash is set for mined transactions.
+	TxHash common.Hash
+}
+
+func (e *RevertError) Error() string {
+	if e.Reason == "" {
+		return ErrExecutionReverted.Error()
+	}
+	return ErrExecutionReverted.Error() + ": " + e.Reason
+}
+
+// Unwrap makes errors.Is(err, ErrExecutionReverted) hold.
+func (e *RevertError) Unwrap() error {
+	return ErrExecutionReverted
+}
+
+// errorRegistry holds the custom errors of the ABIs registered with the helper.
+type errorRegistry struct {
+	mu     sync.Mutex
+	errors map[[4]byte]abi.Error
+}
+
+// RegisterErrorABI adds the custom errors of abiJSON to those decoded from
+// revert data. Contracts bound with BindContract are registered already.
+func (w *Web3GolangHelper) RegisterErrorABI(abiJSON string) error {
+	parsed, err := ParseABI(abiJSON)
+	if err != nil {
+		return err
+	}
+
+	w.registerErrors(parsed)
+	return nil
+}
+
+func (w *Web3GolangHelper) registerErrors(parsed abi.ABI) {
+	w.revertErrors.mu.Lock()
+	defer w.revertErrors.mu.Unlock()
+
+	if w.revertErrors.errors == nil {
+		w.revertErrors.errors = make(map[[4]byte]abi.Error)
+	}
+	for _, e := range parsed.Errors {
+		var selector [4]byte
+		copy(selector[:], e.ID[:4])
+		w.revertErrors.errors[selector] = e
+	}
+}
+
+func (w *Web3GolangHelper) lookupError(selector [4]byte) (abi.Error, bool) {
+	w.revertErrors.mu.Lock()
+	defer w.revertErrors.mu.Unlock()
+
+	e, ok := w.revertErrors.errors[selector]
+	return e, ok
+}
+
+// DecodeRevertData decodes the data returned by a reverted call.
+func (w *Web3GolangHelper) DecodeRevertData(data []byte) *RevertError {
+	revert := &RevertError{Data: data}
+	if len(data) < 4 {
+		return revert
+	}
+
+	var selector [4]byte
+	copy(selector[:], data[:4])
+
+	switch selector {
+	case errorStringSelector:
+		if reason, err := abi.UnpackRevert(data); err == nil {
+			revert.Name = "Error"
+			revert.Reason = reason
+			revert.Arguments = []DecodedArgument{{Name: "message", Type: "string", Value: reason}}
+		}
+		return revert
+
+	case panicSelector:
+		if len(data) == 36 {
+			code := new(big.Int).SetBytes(data[4:])
+			revert.Name = "Panic"
+			revert.Reason = fmt.Sprintf("panic 0x%x", code)
+			if description, ok := panicReasons[code.Uint64()]; ok && code.IsUint64() {
+				revert.Reason += " (" + description + ")"
+			}
+			revert.Arguments = []DecodedArgument{{Name: "code", Type: "uint256", Value: code}}
+		}
+		return revert
+	}
+
+	e, ok := w.lookupError(selector)
+	if !ok {
+		revert.Reason = "unknown error " + hexutil.Encode(data[:4])
+		return revert
+	}
+
+	values, err := e.Inputs.Unpack(data[4:])
+	if err != nil {
+		revert.Reason = "undecodable " + e.Sig
+		return revert
+	}
+
+	revert.Name = e.Name
+	formatted := make([]string, len(values))
+	for i, input := range e.Inputs {
+		revert.Arguments = append(revert.Arguments, DecodedArgument{
+			Name:  input.Name,
+			Type:  input.Type.String(),
+			Value: values[i],
+		})
+		formatted[i] = fmt.Sprint(values[i])
+	}
+	revert.Reason = e.Name + "(" + strings.Join(formatted, ", ") + ")"
+	return revert
+}
+
+// DecodeRevert returns the revert carried by err, nil when err is not one.
+// Nodes attach the revert data to the rpc error; those only sending the
+// message are decoded from the text.
+func (w *Web3GolangHelper) DecodeRevert(err error) *RevertError {
+	if err == nil {
+		return nil
+	}
+
+	var revert *RevertError
+	if errors.As(err, &revert) {
+		return revert
+	}
+
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		switch data := dataErr.ErrorData().(type) {
+		case string:
+			if decoded, decodeErr := hexutil.Decode(data); decodeErr == nil {
+				return w.DecodeRevertData(decoded)
+			}
+		case []byte:
+			return w.DecodeRevertData(data)
+		}
+	}
+
+	message := err.Error()
+	index := strings.Index(message, ErrExecutionReverted.Error())
+	if index < 0 {
+		return nil
+	}
+
+	reason := strings.TrimPrefix(message[index+len(ErrExecutionReverted.Error()):], ":")
+	return &RevertError{Reason: strings.TrimSpace(reason)}
+}
+
+// SetSimulateBeforeSend makes every send run the transaction with eth_call
+// at the pending block first, returning a *RevertError instead of
+// broadcasting a transaction that would revert.
+func (w *Web3GolangHelper) SetSimulateBeforeSend(enabled bool) {
+	w.simulateBeforeSend = enabled
+}
+
+// Simulate runs msg with eth_call at the pending block, or the latest one
+// on backends without pending state, and returns its output.
+func (w *Web3GolangHelper) Simulate(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	var (
+		output []byte
+		err    error
+	)
+
+	backend := w.selectClient()
+	if pending, ok := backend.(bind.PendingContractCaller); ok {
+		output, err = pending.PendingCallContract(ctx, msg)
+	} else {
+		output, err = backend.CallContract(ctx, msg, nil)
+	}
+
+	if revert := w.DecodeRevert(err); revert != nil {
+		return nil, revert
+	}
+	return output, err
+}
+
+// simulateTx runs a signed transaction with Simulate.
+func (w *Web3GolangHelper) simulateTx(ctx context.Context, tx *types.Transaction) error {
+	msg, err := txCallMsg(tx)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Simulate(ctx, msg)
+	return err
+}
+
+// sendTransaction broadcasts tx, simulating it first when enabled, and
+// reports the most it can pay in fees.
+func (w *Web3GolangHelper) sendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if w.simulateBeforeSend {
+		if err := w.simulateTx(ctx, tx); err != nil {
+			return err
+		}
+	}
+
+	maxFee := CalcGasCost(tx.Gas(), tx.GasFeeCap())
+	w.log(InfoLogLevel, "transaction max fee",
+		"txHash", tx.Hash(),
+		"gasLimit", tx.Gas(),
+		"maxFee", maxFee,
+		"maxFeeNative", w.toNative(maxFee).String())
+
+	if w.privateRelay != nil {
+		_, err := w.sendPrivateTransaction(ctx, tx)
+		return err
+	}
+	if w.parallelBroadcast {
+		_, err := w.BroadcastTransaction(ctx, tx)
+		return err
+	}
+	return w.selectClient().SendTransaction(ctx, tx)
+}
+
+func txCallMsg(tx *types.Transaction) (ethereum.CallMsg, error) {
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+
+	return ethereum.CallMsg{
+		From:       from,
+		To:         tx.To(),
+		Gas:        tx.Gas(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	}, nil
+}
+
+// TransactionRevert replays a mined transaction that reverted on the state
+// of its parent block and returns the decoded revert, nil when it
+// succeeded. The revert is empty when the reason is unknown, e.g. on nodes
+// without that state like pruned or simulated ones.
+func (w *Web3GolangHelper) TransactionRevert(ctx context.Context, txHash string) (*RevertError, error) {
+	hash := common.HexToHash(txHash)
+
+	receipt, err := w.selectClient().TransactionReceipt(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		return nil, nil
+	}
+
+	tx, _, err := w.selectClient().TransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.replayRevert(ctx, tx, receipt)
+}
+
+func (w *Web3GolangHelper) replayRevert(ctx context.Context, tx *types.Transaction, receipt *types.Receipt) (*RevertError, error) {
+	msg, err := txCallMsg(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	parent := new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1))
+	_, err = w.selectClient().CallContract(ctx, msg, parent)
+	revert := w.DecodeRevert(err)
+
+	// out of gas and other failures leave no revert data to replay. Missing
+	// state is not replayed on the latest one, which may revert for another
+	// reason.
+	if revert == nil {
+		revert = &RevertError{}
+	}
+
+	revert.TxHash = tx.Hash()
+	return revert, nil
+}
+
+// sendBackend is the backend of one send through a binding. It simulates
+// transactions before broadcasting them when enabled and keeps the error of
+// a failed gas estimation, which bindings only report as text.
+type sendBackend struct {
+	Backend
+	helper  *Web3GolangHelper
+	failure error
+}
+
+func (w *Web3GolangHelper) newSendBackend() *sendBackend {
+	return &sendBackend{Backend: w.selectClient(), helper: w}
+}
+
+// EstimateGas applies the gas limit strategy to the estimates of bindings.
+func (b *sendBackend) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	gas, err := b.helper.GasLimit(ctx, msg)
+	if err != nil {
+		b.failure = err
+	}
+	return gas, err
+}
+
+func (b *sendBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	err := b.helper.sendTransaction(ctx, tx)
+	if revert := b.helper.DecodeRevert(err); revert != nil {
+		return revert
+	}
+	return err
+}
+
+// err returns the estimation error the send ran into in place of err.
+func (b *sendBackend) err(err error) error {
+	if b.failure != nil {
+		return b.failure
+	}
+	return err
+}
@@ -5,19 +5,16 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"encoding/gob"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"image/color"
-	"io/ioutil"
+	"io"
 	"log"
 	"math/big"
 	"net/url"
-	"os"
 	"reflect"
 	"regexp"
-	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -27,20 +24,16 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/params"
-	ccolor "github.com/fatih/color"
-	"github.com/hokaccha/go-prettyjson"
-	"github.com/hrharder/go-gas"
-	"github.com/mdp/qrterminal"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/shopspring/decimal"
-	qrcode "github.com/skip2/go-qrcode"
 	"golang.org/x/crypto/sha3"
 
 	//web3utils "github.com/nikola43/goweb3manager/goweb3manager/util"
-	pancakeFactory "github.com/nikola43/web3golanghelper/contracts/IPancakeFactory"
-	pancakePair "github.com/nikola43/web3golanghelper/contracts/IPancakePair"
-	pancakeRouter "github.com/nikola43/web3golanghelper/contracts/IPancakeRouter02"
-	"github.com/nikola43/web3golanghelper/genericutils"
+	pancakeFactory "github.com/nikola43/web3golanghelper/contracts/bindings/ipancakefactory"
+	pancakePair "github.com/nikola43/web3golanghelper/contracts/bindings/ipancakepair"
+	pancakeRouter "github.com/nikola43/web3golanghelper/contracts/bindings/ipancakerouter02"
 )
 
 type Reserve struct {
@@ -49,27 +42,50 @@ type Reserve struct {
 	BlockTimestampLast uint32
 }
 
-type LogLevel int
-
-const (
-	NoneLogLevel   LogLevel = 0
-	LowLogLevel    LogLevel = 1
-	MediumLogLevel LogLevel = 2
-	HighLogLevel   LogLevel = 3
-)
-
-var defaultGasLimit = uint64(100000)
-var logLevel = HighLogLevel
-
 type Account struct {
 	PublicKey  string `json:"PublicKey"`
 	PrivateKey string `json:"PrivateKey"`
 }
 
 type Web3GolangHelper struct {
-	httpClient *ethclient.Client
-	wsClient   *ethclient.Client
-	accounts   []*common.Address
+	httpClient    *ethclient.Client
+	httpRpcClient *rpc.Client
+	wsClient      *ethclient.Client
+	wsRpcClient   *rpc.Client
+	backend       Backend
+	accounts      []*common.Address
+
+	network     *EVMNetwork
+	txSentHooks []TxSentHook
+
+	resultRenderer Renderer
+	resultWriter   io.Writer
+
+	logger   Logger
+	logLevel LogLevel
+
+	metrics *metricsRecorder
+
+	simulateBeforeSend bool
+	revertErrors       errorRegistry
+	gasStrategy        *GasLimitStrategy
+	autoAccessList     bool
+
+	parallelBroadcast bool
+	broadcastMu       sync.Mutex
+	broadcastUrls     []string
+	broadcastClients  map[string]*rpc.Client
+
+	privateRelay *PrivateRelay
+	privateTxsMu sync.Mutex
+	privateTxs   map[common.Hash]uint64
+
+	feeOracleMu sync.Mutex
+	feeOracle   *FeeOracle
+	feePriority FeePriority
+
+	chainIDMu sync.Mutex
+	chainID   *big.Int
 }
 
 func (w *Web3GolangHelper) AddHttpClient(httpClient *ethclient.Client) error {
@@ -78,6 +94,10 @@ func (w *Web3GolangHelper) AddHttpClient(httpClient *ethclient.Client) error {
 		return errors.New("web3 Http provider already instanced")
 	}
 
+	if err := w.checkClientChainID(httpClient); err != nil {
+		return err
+	}
+
 	w.httpClient = httpClient
 	return nil
 }
@@ -88,16 +108,38 @@ func (w *Web3GolangHelper) AddWsClient(wsClient *ethclient.Client) error {
 		return errors.New("web3 websocket provider already instanced")
 	}
 
+	if err := w.checkClientChainID(wsClient); err != nil {
+		return err
+	}
+
 	w.wsClient = wsClient
 	return nil
 }
 
+func (w *Web3GolangHelper) AddWsRpcClient(wsRpcClient *rpc.Client) error {
+
+	if w.wsClient != nil {
+		return errors.New("web3 websocket provider already instanced")
+	}
+
+	wsClient := ethclient.NewClient(wsRpcClient)
+	if err := w.checkClientChainID(wsClient); err != nil {
+		return err
+	}
+
+	w.wsClient = wsClient
+	w.wsRpcClient = wsRpcClient
+	return nil
+}
+
+// SuggestGasPrice returns the legacy gas price of the fee priority, see
+// SetFeePriority.
 func (w *Web3GolangHelper) SuggestGasPrice() *big.Int {
 
-	gasPrice, err := w.selectClient().SuggestGasPrice(context.Background())
+	gasPrice, err := w.suggestGasPrice(context.Background())
 
 	if err != nil {
-		fmt.Println(err)
+		w.log(WarnLogLevel, "suggest gas price failed", "err", err)
 		return big.NewInt(0)
 	}
 
@@ -106,38 +148,121 @@ func (w *Web3GolangHelper) SuggestGasPrice() *big.Int {
 
 func NewWeb3GolangHelperFromNetwork(network EVMNetwork) *Web3GolangHelper {
 
-	var accounts = make([]*common.Address, 0)
+	goWeb3Manager, err := DialNetwork(network)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	goWeb3WsManager := NewWsWeb3Client(
-		network.WebsocketUrl)
+	return goWeb3Manager
 
-	goWeb3HttpManager := NewHttpWeb3Client(
-		network.HttpUrl)
+}
+
+// DialNetwork connects to the first reachable endpoints of network and
+// verifies their chain ID, returning the errors NewWeb3GolangHelperFromNetwork
+// exits on.
+func DialNetwork(network EVMNetwork) (*Web3GolangHelper, error) {
+
+	var accounts = make([]*common.Address, 0)
+
+	metrics := &metricsRecorder{}
+	goWeb3HttpRpcClient, err := dialFirst(network.HttpUrls(), func(rpcUrl string) (*rpc.Client, error) {
+		return dialMeteredHttpClient(rpcUrl, metrics)
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	goWeb3Manager := &Web3GolangHelper{
-		httpClient: goWeb3HttpManager,
-		wsClient:   goWeb3WsManager,
-		accounts:   accounts,
+		httpClient:    ethclient.NewClient(goWeb3HttpRpcClient),
+		httpRpcClient: goWeb3HttpRpcClient,
+		accounts:      accounts,
+		network:       &network,
+		metrics:       metrics,
+		feePriority:   StandardFeePriority,
+	}
+
+	// websocket endpoints are optional, subscriptions fail without them
+	if len(network.WebsocketUrls()) > 0 {
+		goWeb3WsRpcClient, err := dialFirst(network.WebsocketUrls(), func(rpcUrl string) (*rpc.Client, error) {
+			return dialWsRpcClient(rpcUrl, metrics)
+		})
+		if err != nil {
+			goWeb3Manager.closeClients()
+			return nil, err
+		}
+
+		goWeb3Manager.wsClient = ethclient.NewClient(goWeb3WsRpcClient)
+		goWeb3Manager.wsRpcClient = goWeb3WsRpcClient
 	}
 
-	return goWeb3Manager
+	// never operate against endpoints of a different chain than configured
+	if err := goWeb3Manager.VerifyChainID(context.Background(), network.ChainID); err != nil {
+		goWeb3Manager.closeClients()
+		return nil, err
+	}
+
+	return goWeb3Manager, nil
+}
+
+// closeClients closes the endpoints dialed by the constructors.
+func (w *Web3GolangHelper) closeClients() {
+	if w.httpRpcClient != nil {
+		w.httpRpcClient.Close()
+	}
+	if w.wsRpcClient != nil {
+		w.wsRpcClient.Close()
+	}
+}
+
+// dialFirst returns the client of the first url that can be dialed. dial
+// closes the clients it fails to check.
+func dialFirst[T any](urls []string, dial func(string) (T, error)) (T, error) {
+	var client T
+	err := errors.New("no endpoint configured")
+
+	for _, rpcUrl := range urls {
+		client, err = dial(rpcUrl)
+		if err == nil {
+			return client, nil
+		}
+	}
 
+	return client, err
 }
 
 func NewWeb3GolangHelper(rpcUrl, wsUrl string) *Web3GolangHelper {
 
 	var accounts = make([]*common.Address, 0)
 
-	goWeb3HttpManager := NewHttpWeb3Client(
-		rpcUrl)
+	metrics := &metricsRecorder{}
+	goWeb3HttpRpcClient, err := dialMeteredHttpClient(rpcUrl, metrics)
+	if err != nil {
+		log.Fatal(err)
+	}
+	goWeb3HttpManager := ethclient.NewClient(goWeb3HttpRpcClient)
 
-	goWeb3WsManager := NewWsWeb3Client(
-		wsUrl)
+	goWeb3WsRpcClient, err := dialWsRpcClient(wsUrl, metrics)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	goWeb3Manager := &Web3GolangHelper{
-		httpClient: goWeb3HttpManager,
-		wsClient:   goWeb3WsManager,
-		accounts:   accounts,
+		httpClient:    goWeb3HttpManager,
+		httpRpcClient: goWeb3HttpRpcClient,
+		wsClient:      ethclient.NewClient(goWeb3WsRpcClient),
+		wsRpcClient:   goWeb3WsRpcClient,
+		accounts:      accounts,
+		metrics:       metrics,
+		feePriority:   StandardFeePriority,
+	}
+
+	chainID, err := goWeb3HttpManager.ChainID(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := goWeb3Manager.VerifyChainID(context.Background(), chainID.Uint64()); err != nil {
+		log.Fatal(err)
 	}
 
 	return goWeb3Manager
@@ -146,24 +271,35 @@ func NewWeb3GolangHelper(rpcUrl, wsUrl string) *Web3GolangHelper {
 
 func NewHttpWeb3Client(rpcUrl string) *ethclient.Client {
 
-	client, err := ethclient.Dial(rpcUrl)
+	client, err := dialHttpClient(rpcUrl)
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	return client
+}
+
+func dialHttpClient(rpcUrl string) (*ethclient.Client, error) {
+
+	client, err := ethclient.Dial(rpcUrl)
+	if err != nil {
+		return nil, err
+	}
+
 	_, getBlockErr := client.BlockNumber(context.Background())
 	if getBlockErr != nil {
-		log.Fatal(getBlockErr)
+		client.Close()
+		return nil, getBlockErr
 	}
 
-	return client
+	return client, nil
 }
 
 func (w *Web3GolangHelper) CurrentBlockNumber() uint64 {
 
-	blockNumber, getBlockErr := w.selectClient().BlockNumber(context.Background())
+	blockNumber, getBlockErr := w.blockNumber(context.Background())
 	if getBlockErr != nil {
-		fmt.Println(getBlockErr)
+		w.log(WarnLogLevel, "get block number failed", "err", getBlockErr)
 		return 0
 	}
 
@@ -179,34 +315,62 @@ func (w *Web3GolangHelper) WebSocketClient() *ethclient.Client {
 }
 
 func NewWsWeb3Client(rpcUrl string) *ethclient.Client {
+	return ethclient.NewClient(newWsRpcClient(rpcUrl))
+}
 
-	_, err := url.ParseRequestURI(rpcUrl)
+// newWsRpcClient dials the websocket endpoint and keeps the raw rpc client,
+// which is needed for subscriptions ethclient does not expose.
+func newWsRpcClient(rpcUrl string) *rpc.Client {
+
+	wsRpcClient, err := dialWsRpcClient(rpcUrl, nil)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	wsClient, wsClientErr := ethclient.Dial(rpcUrl)
+	return wsRpcClient
+}
+
+// dialWsRpcClient dials rpcUrl, reporting the calls of websocket endpoints to
+// metrics when set.
+func dialWsRpcClient(rpcUrl string, metrics *metricsRecorder) (*rpc.Client, error) {
+
+	_, err := url.ParseRequestURI(rpcUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var wsRpcClient *rpc.Client
+	var wsClientErr error
+	switch {
+	case !isWebsocketUrl(rpcUrl):
+		wsRpcClient, wsClientErr = rpc.Dial(rpcUrl)
+	case metrics != nil:
+		wsRpcClient, wsClientErr = dialMeteredWsClient(context.Background(), rpcUrl, metrics)
+	default:
+		wsRpcClient, wsClientErr = rpc.DialWebsocket(context.Background(), rpcUrl, "")
+	}
 	if wsClientErr != nil {
-		log.Fatal(wsClientErr)
+		return nil, wsClientErr
 	}
 
-	_, getBlockErr := wsClient.BlockNumber(context.Background())
+	_, getBlockErr := ethclient.NewClient(wsRpcClient).BlockNumber(context.Background())
 	if getBlockErr != nil {
-		log.Fatal(getBlockErr)
+		wsRpcClient.Close()
+		return nil, getBlockErr
 	}
 
-	return wsClient
+	return wsRpcClient, nil
 }
 
 func (w *Web3GolangHelper) Unsubscribe() {
 	time.Sleep(10 * time.Second)
-	fmt.Println("---unsubscribe-----")
+	w.log(DebugLogLevel, "unsubscribe")
 	//w.ethSubscription.Unsubscribe()
 }
 
 func (w *Web3GolangHelper) GetEthBalance(address string) *big.Int {
 	account := common.HexToAddress(address)
-	balance, err := w.httpClient.BalanceAt(context.Background(), account, nil)
+	balance, err := w.selectClient().BalanceAt(context.Background(), account, nil)
 	if err != nil {
 		return nil
 	}
@@ -219,15 +383,16 @@ func (w *Web3GolangHelper) IsAddressContract(address string) bool {
 		return false
 	}
 
-	bytecode, err := w.httpClient.CodeAt(context.Background(), common.HexToAddress(address), nil)
+	bytecode, err := w.selectClient().CodeAt(context.Background(), common.HexToAddress(address), nil)
 	if err != nil {
 		return false
 	}
 	return len(bytecode) > 0
 }
 
+// ChainId returns the eth_chainId of the connected network, cached on connect.
 func (w *Web3GolangHelper) ChainId() *big.Int {
-	chainID, err := w.httpClient.NetworkID(context.Background())
+	chainID, err := w.cachedChainID(context.Background())
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -273,7 +438,8 @@ func (w *Web3GolangHelper) NewContract(contractAddress string) {
 
 func (w *Web3GolangHelper) SubscribeContractBridgeBSCEvent(contractAddressString string) error {
 
-	if w.wsClient == nil {
+	subscriptionBackend := w.subscriptionBackend()
+	if subscriptionBackend == nil {
 		return errors.New("Nil Web3 Websocket Client")
 	}
 
@@ -282,24 +448,23 @@ func (w *Web3GolangHelper) SubscribeContractBridgeBSCEvent(contractAddressString
 	}
 
 	logs := make(chan types.Log)
-	sub, err := w.wsClient.SubscribeFilterLogs(context.Background(), query, logs)
+	sub, err := subscriptionBackend.SubscribeFilterLogs(context.Background(), query, logs)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	fmt.Println("Init Sub")
+	w.log(InfoLogLevel, "subscribed to contract logs", "contract", contractAddressString)
 	for {
 		select {
 		case err := <-sub.Err():
-			fmt.Println("Error")
-			fmt.Println(err)
-			log.Fatal(err)
+			w.log(ErrorLogLevel, "contract logs subscription failed", "contract", contractAddressString, "err", err)
+			return err
 		case vLog := <-logs:
-			fmt.Println("Data")
-			fmt.Println(string(vLog.Data))
-			//fmt.Println("vLog.Address: " + vLog.Address.Hex())
-			fmt.Println("vLog.TxHash: " + vLog.TxHash.Hex())
-			fmt.Println("vLog.BlockNumber: " + strconv.FormatUint(vLog.BlockNumber, 10))
+			w.log(DebugLogLevel, "contract log",
+				"contract", vLog.Address.Hex(),
+				"txHash", vLog.TxHash,
+				"block", vLog.BlockNumber,
+				"data", hexutil.Bytes(vLog.Data))
 
 			/*
 
@@ -326,9 +491,6 @@ func (w *Web3GolangHelper) SubscribeContractBridgeBSCEvent(contractAddressString
 
 			//fmt.Println(string(event.Key[:]))   // foo
 			//fmt.Println(string(event.Value[:])) // bar
-
-			fmt.Println("")
-			//fmt.Println(vLog) // pointer to event log
 		}
 	}
 }
@@ -338,27 +500,51 @@ func (w *Web3GolangHelper) EstimateTxResult(to string, txData []byte) bool {
 	return estimatedGas > 0
 }
 
+// EstimateGas returns the gas of calling to with txData, 0 when the call
+// fails or reverts.
 func (w *Web3GolangHelper) EstimateGas(to string, txData []byte) uint64 {
 	toAddress := common.HexToAddress(to)
-	estimateGas, estimateGasErr := w.selectClient().EstimateGas(context.Background(), ethereum.CallMsg{
+	estimateGas, estimateGasErr := w.estimateGas(context.Background(), ethereum.CallMsg{
 		To:   &toAddress,
 		Data: txData,
 	})
 	if estimateGasErr != nil {
-		panic(estimateGasErr)
+		w.log(WarnLogLevel, "gas estimation failed", "to", to, "err", estimateGasErr)
+		return 0
 	}
 	return estimateGas
 }
 
+// estimateGas returns a *RevertError when msg reverts.
+func (w *Web3GolangHelper) estimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	var (
+		gas uint64
+		err error
+	)
+
+	// ethclient drops the access list from the call
+	if rpcClient := w.rpcClient(); rpcClient != nil && msg.AccessList != nil {
+		var estimated hexutil.Uint64
+		err = rpcClient.CallContext(ctx, &estimated, "eth_estimateGas", toCallArg(msg))
+		gas = uint64(estimated)
+	} else {
+		gas, err = w.selectClient().EstimateGas(ctx, msg)
+	}
+	if revert := w.DecodeRevert(err); revert != nil {
+		return 0, revert
+	}
+	return gas, err
+}
+
 func (w *Web3GolangHelper) BuildContractEventSubscription(contractAddress string, logs chan types.Log) ethereum.Subscription {
 
 	query := ethereum.FilterQuery{
 		Addresses: []common.Address{common.HexToAddress(contractAddress)},
 	}
 
-	sub, err := w.WebSocketClient().SubscribeFilterLogs(context.Background(), query, logs)
+	sub, err := w.subscriptionBackend().SubscribeFilterLogs(context.Background(), query, logs)
 	if err != nil {
-		fmt.Println(sub)
+		w.log(ErrorLogLevel, "contract logs subscription failed", "contract", contractAddress, "err", err)
 	}
 	return sub
 }
@@ -379,7 +565,6 @@ func (w *Web3GolangHelper) SendTokens(tokenAddressString, toAddressString string
 	}
 
 	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
-	println("fromAddress: " + fromAddress.Hex())
 
 	transferFnSignature := []byte("transfer(address,uint256)")
 	hash := sha3.NewLegacyKeccak256()
@@ -390,11 +575,12 @@ func (w *Web3GolangHelper) SendTokens(tokenAddressString, toAddressString string
 
 	nonce := w.PendingNonce(fromAddress)
 
-	fmt.Println("fromAddress: " + fromAddress.Hex())
-	fmt.Println("paddedAddress", hexutil.Encode(paddedAddress)) // 0x0000000000000000000000004592d8f8d7b001e72cb26a73e4fa1806a51ac79d
-	fmt.Println("paddedAmount", hexutil.Encode(paddedAmount)) // 0x0000000000000000000000004592d8f8d7b001e72cb26a73e4fa1806a51ac79d
-	fmt.Println("methodID", hexutil.Encode(methodID)) // 0x0000000000000000000000004592d8f8d7b001e72cb26a73e4fa1806a51ac79d
-
+	w.log(DebugLogLevel, "token transfer",
+		"token", tokenAddressString,
+		"from", fromAddress.Hex(),
+		"to", toAddress.Hex(),
+		"amount", value,
+		"nonce", nonce)
 
 	txData := BuildTxData(methodID, paddedAddress, paddedAmount)
 
@@ -407,20 +593,35 @@ func (w *Web3GolangHelper) SendTokens(tokenAddressString, toAddressString string
 	return txId, txNonce, nil
 }
 
-func (w *Web3GolangHelper) selectClient() *ethclient.Client {
-	var selectedClient *ethclient.Client
-	if w.httpClient != nil {
+// selectClient returns the backend serving calls, one failing with
+// ErrNotConnected when the helper has none.
+func (w *Web3GolangHelper) selectClient() Backend {
+	var selectedClient Backend
+	if w.backend != nil {
+		selectedClient = w.backend
+	} else if w.httpClient != nil {
 		selectedClient = w.httpClient
+	} else if w.wsClient != nil {
+		selectedClient = w.wsClient
 	} else {
-		if w.wsClient != nil {
-			selectedClient = w.wsClient
-		} else {
-			log.Fatal("SuggestGasPrice: Not conected")
-		}
+		selectedClient = notConnected{}
 	}
 	return selectedClient
 }
 
+// rpcClient returns the rpc client of the endpoint selectClient picks, for
+// the methods ethclient lacks. Backends have none.
+func (w *Web3GolangHelper) rpcClient() *rpc.Client {
+	switch {
+	case w.backend != nil:
+		return nil
+	case w.httpClient != nil:
+		return w.httpRpcClient
+	default:
+		return w.wsRpcClient
+	}
+}
+
 func (w *Web3GolangHelper) SendEth(fromAddress common.Address, toAddressString string, value string, pk string) (string, *big.Int, error) {
 
 	txId, nonce, err := w.SignAndSendTransaction(toAddressString, ToWei(value, 18), make([]byte, 0), w.PendingNonce(fromAddress), nil, nil, pk)
@@ -433,114 +634,147 @@ func (w *Web3GolangHelper) SendEth(fromAddress common.Address, toAddressString s
 
 func (w *Web3GolangHelper) SignAndSendTransaction(toAddressString string, value *big.Int, data []byte, nonce *big.Int, customGasPrice interface{}, customGasLimit interface{}, pk string) (string, *big.Int, error) {
 
-	usedGasPrice, _ := w.selectClient().SuggestGasPrice(context.Background())
-	if logLevel == MediumLogLevel {
-		fmt.Println(ccolor.CyanString("usedGasPrice -> suggestGasPrice: "), ccolor.YellowString(strconv.Itoa(int(usedGasPrice.Int64())))+"\n")
+	result, err := w.SignAndSend(toAddressString, value, data, nonce, customGasPrice, customGasLimit, pk)
+	if err != nil {
+		return "", big.NewInt(0), err
 	}
 
+	return result.Hash, nonce, nil
+}
+
+// SignAndSend signs and broadcasts a legacy transaction and returns its
+// structured result, rendered to the output set with SetResultOutput.
+func (w *Web3GolangHelper) SignAndSend(toAddressString string, value *big.Int, data []byte, nonce *big.Int, customGasPrice interface{}, customGasLimit interface{}, pk string) (*TxResult, error) {
+
+	var usedGasPrice *big.Int
+	gasPriceSource := "oracle"
+
 	if customGasPrice != nil {
 		usedGasPrice = customGasPrice.(*big.Int)
-
-		if logLevel == MediumLogLevel {
-			fmt.Println(ccolor.CyanString("usedGasPrice -> customGasPrice: "), ccolor.YellowString(strconv.Itoa(int(usedGasPrice.Int64())))+"\n")
+		gasPriceSource = "custom"
+	} else {
+		gasPrice, gasPriceErr := w.suggestGasPrice(context.Background())
+		if gasPriceErr != nil {
+			return nil, gasPriceErr
 		}
+		usedGasPrice = gasPrice
 	}
 
-	usedGasLimit := defaultGasLimit
-	if logLevel == MediumLogLevel {
-		fmt.Println(ccolor.CyanString("usedGasLimit -> defaultGasLimit: "), ccolor.YellowString(strconv.Itoa(int(usedGasLimit)))+"\n")
+	privateKey, err := crypto.HexToECDSA(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	toAddress := common.HexToAddress(toAddressString)
+
+	var usedGasLimit uint64
+	gasLimitSource := "strategy"
+
+	msg := ethereum.CallMsg{
+		From:     crypto.PubkeyToAddress(privateKey.PublicKey),
+		To:       &toAddress,
+		GasPrice: usedGasPrice,
+		Value:    value,
+		Data:     data,
 	}
 
 	if customGasLimit != nil {
 		usedGasLimit = customGasLimit.(uint64)
-
-		if logLevel == MediumLogLevel {
-			fmt.Println(ccolor.CyanString("usedGasLimit -> customGasLimit: "), ccolor.YellowString(strconv.Itoa(int(usedGasLimit)))+"\n")
-		}
+		gasLimitSource = "custom"
 	} else {
-		if len(data) > 0 {
-			usedGasLimit = w.EstimateGas(toAddressString, data)
-			if logLevel == MediumLogLevel {
-				fmt.Println(ccolor.CyanString("usedGasLimit -> w.EstimateGas: "), ccolor.YellowString(strconv.Itoa(int(usedGasLimit)))+"\n")
+		if w.autoAccessList && len(data) > 0 {
+			if err := w.attachAccessList(context.Background(), &msg); err != nil {
+				return nil, err
 			}
-		} else {
+		}
 
+		if msg.AccessList != nil {
+			usedGasLimit = msg.Gas
+			gasLimitSource = "accessList"
+		} else if usedGasLimit, err = w.GasLimit(context.Background(), msg); err != nil {
+			return nil, err
 		}
 	}
 
-	toAddress := common.HexToAddress(toAddressString)
-	fmt.Println("usedGasLimit: ", usedGasLimit)
-	fmt.Println("usedGasPrice: ", usedGasPrice)
+	w.log(DebugLogLevel, "transaction gas",
+		"gasPrice", usedGasPrice,
+		"gasPriceSource", gasPriceSource,
+		"gasLimit", usedGasLimit,
+		"gasLimitSource", gasLimitSource)
+
+	chainID, err := w.cachedChainID(context.Background())
+	if err != nil {
+		return nil, err
+	}
 
 	tx := types.NewTransaction(nonce.Uint64(), toAddress, value, usedGasLimit, usedGasPrice, data)
+	var signer types.Signer = types.NewEIP155Signer(chainID)
+	if msg.AccessList != nil {
+		tx = types.NewTx(&types.AccessListTx{
+			ChainID:    chainID,
+			Nonce:      nonce.Uint64(),
+			GasPrice:   usedGasPrice,
+			Gas:        usedGasLimit,
+			To:         &toAddress,
+			Value:      value,
+			Data:       data,
+			AccessList: msg.AccessList,
+		})
+		signer = types.LatestSignerForChainID(chainID)
+	}
 
 	/*
-	tx := types.NewTx(&types.LegacyTx{
-		Nonce:    nonce.Uint64(),
-		GasPrice: usedGasPrice,
-		Gas:      usedGasLimit,
-		To:       &toAddress,
-		Value:    value,
-		Data:     data,
-	})
+		tx := types.NewTx(&types.LegacyTx{
+			Nonce:    nonce.Uint64(),
+			GasPrice: usedGasPrice,
+			Gas:      usedGasLimit,
+			To:       &toAddress,
+			Value:    value,
+			Data:     data,
+		})
 	*/
 
-    chainID, err := w.selectClient().NetworkID(context.Background())
-    if err != nil {
-        log.Fatal(err)
-    }
-
-	privateKey, err := crypto.HexToECDSA(pk)
-    if err != nil {
-        log.Fatal(err)
-    }
+	signedTx, err := types.SignTx(tx, signer, privateKey)
+	if err != nil {
+		return nil, err
+	}
 
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), privateKey)
-    if err != nil {
-        log.Fatal(err)
-    }
+	result := NewTxResult(signedTx)
+	w.recordNonceGap(context.Background(), common.HexToAddress(result.From), result.Nonce)
 
-	sendTxErr := w.selectClient().SendTransaction(context.Background(), signedTx)
+	sendTxErr := w.sendTransaction(context.Background(), signedTx)
 	if sendTxErr != nil {
-		return "", big.NewInt(0), sendTxErr
+		w.recordTxRejected()
+		w.log(ErrorLogLevel, "send transaction failed", "txHash", signedTx.Hash(), "nonce", result.Nonce, "from", result.From, "err", sendTxErr)
+		return nil, sendTxErr
 	}
 
-	if logLevel == HighLogLevel {
-
-		b, e := signedTx.MarshalJSON()
-		if e != nil {
-			fmt.Println("SendTransaction")
-			return "", big.NewInt(0), e
-		}
-
-		var result map[string]interface{}
-		json.Unmarshal(b, &result)
-		s, _ := prettyjson.Marshal(result)
-
-		timestamp := time.Now().Unix()
+	if explorer, err := w.Explorer(); err == nil {
+		result.ExplorerUrl = explorer.TxUrl(result.Hash)
+	}
 
-		fmt.Println(ccolor.GreenString("Raw Transaction Hash: "), ccolor.YellowString(tx.Hash().Hex()))
-		fmt.Println(ccolor.CyanString("Transaction Hash: "), ccolor.YellowString(signedTx.Hash().Hex()))
-		fmt.Println(ccolor.MagentaString("Timestamp: "), ccolor.YellowString(strconv.Itoa(int(timestamp))))
-		fmt.Println(string(s))
+	w.log(InfoLogLevel, "transaction sent", "txHash", signedTx.Hash(), "nonce", result.Nonce, "from", result.From, "to", result.To)
 
-		//OpenBrowser("https://testnet.snowtrace.io/tx/" + singedTx.Hash().Hex())
-	}
+	w.renderResult(result)
+	w.notifyTxSent(signedTx)
 
-	return signedTx.Hash().Hex(), nonce, nil
+	return result, nil
 }
 
 func (w *Web3GolangHelper) CancelTx(to string, nonce *big.Int, multiplier int64, pk string) (string, error) {
 
-	gasPrice, _ := w.selectClient().SuggestGasPrice(context.Background())
+	gasPrice, gasPriceErr := w.suggestGasPrice(context.Background())
+	if gasPriceErr != nil {
+		return "", gasPriceErr
+	}
 
 	txId, _, err := w.SignAndSendTransaction(
 		to,
 		ToWei(0, 0),
 		make([]byte, 0),
 		nonce,
-		nil,
-		big.NewInt(gasPrice.Int64()*multiplier), pk)
+		new(big.Int).Mul(gasPrice, big.NewInt(multiplier)),
+		nil, pk)
 
 	if err != nil {
 		return "", err
@@ -551,12 +785,17 @@ func (w *Web3GolangHelper) CancelTx(to string, nonce *big.Int, multiplier int64,
 
 func (w *Web3GolangHelper) GenerateContractEventSubscription(contractAddress string) (chan types.Log, ethereum.Subscription, error) {
 
+	subscriptionBackend := w.subscriptionBackend()
+	if subscriptionBackend == nil {
+		return nil, nil, errors.New("Nil Web3 Websocket Client")
+	}
+
 	logs := make(chan types.Log)
 	query := ethereum.FilterQuery{
 		Addresses: []common.Address{common.HexToAddress(contractAddress)},
 	}
 
-	sub, err := w.wsClient.SubscribeFilterLogs(context.Background(), query, logs)
+	sub, err := subscriptionBackend.SubscribeFilterLogs(context.Background(), query, logs)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -564,41 +803,35 @@ func (w *Web3GolangHelper) GenerateContractEventSubscription(contractAddress str
 	return logs, sub, nil
 }
 
-func (w *Web3GolangHelper) Buy(fromAddress common.Address, tokenAddress string, bnbAmount float64) {
+// Buy swaps bnbAmount for tokenAddress on PancakeRouter, signing with pk and
+// sending the tokens to fromAddress, and returns the swap tx hash.
+func (w *Web3GolangHelper) Buy(fromAddress common.Address, tokenAddress string, bnbAmount float64, pk string) (string, error) {
 	// contract addresses
-	pancakeContractAddress := common.HexToAddress("0x9Ac64Cc6e4415144C455BD8E4837Fea55603e5c3") // pancake router address
-	wBnbContractAddress := "0xae13d989daC2f0dEbFf460aC112a837C89BAa7cd"                         // wbnb token adddress
-	tokenContractAddress := common.HexToAddress(tokenAddress)                                   // eth token adddress
+	pancakeContractAddress, _, wBnbAddress, err := w.defaultDex()
+	if err != nil {
+		return "", err
+	}
+	wBnbContractAddress := wBnbAddress.Hex()
+	tokenContractAddress := common.HexToAddress(tokenAddress)
 
 	// create pancakeRouter pancakeRouterInstance
-	pancakeRouterInstance, instanceErr := pancakeRouter.NewPancake(pancakeContractAddress, w.HttpClient())
+	backend := w.newSendBackend()
+	pancakeRouterInstance, instanceErr := pancakeRouter.NewIPancakeRouter02(pancakeContractAddress, backend)
 	if instanceErr != nil {
-		fmt.Println(instanceErr)
+		return "", instanceErr
 	}
 
-	// calculate gas and gas limit
-	gasLimit := uint64(21000000) // in units
-	gasPrice, gasPriceErr := gas.SuggestGasPrice(gas.GasPrioritySafeLow)
+	// calculate gas, the gas limit is left to the gas limit strategy
+	gasPrice, gasPriceErr := w.suggestGasPrice(context.Background())
 	if gasPriceErr != nil {
-		fmt.Println(gasPriceErr)
+		return "", gasPriceErr
 	}
 
-	fmt.Println(
-
-		wBnbContractAddress,
-		tokenContractAddress,
-		pancakeRouterInstance,
-		gasLimit,
-		gasPrice,
-	)
-
-	// calculate fee and final value
-	gasFee := CalcGasCost(gasLimit, gasPrice)
+	// calculate final value
 	ethValue := EtherToWei(big.NewFloat(bnbAmount))
 	//finalValue := big.NewInt(0).Add(ethValue, gasFee)
 	//finalValue := big.NewInt(0).Sub(ethValue, gasFee)
 	//fmt.Println("finalValue", finalValue)
-	fmt.Println("gasFee", gasFee)
 	// set transaction data
 
 	path := GeneratePath(wBnbContractAddress, tokenContractAddress.Hex())
@@ -606,18 +839,23 @@ func (w *Web3GolangHelper) Buy(fromAddress common.Address, tokenAddress string,
 	opts := &bind.CallOpts{}
 	amountOutMin, getAmountsOutErr := pancakeRouterInstance.GetAmountsOut(opts, ethValue, path)
 	if getAmountsOutErr != nil {
-		fmt.Println(getAmountsOutErr)
+		return "", getAmountsOutErr
 	}
 
 	deadline := big.NewInt(time.Now().Unix() + 10000)
-	transactor := w.BuildTransactor(fromAddress, ethValue, gasPrice, gasLimit)
+	transactor, transactorErr := w.BuildTransactor(pk, ethValue, gasPrice, 0)
+	if transactorErr != nil {
+		return "", transactorErr
+	}
 
-	fmt.Println("transactor", transactor)
-	fmt.Println("amountOutMin[1]", amountOutMin)
-	fmt.Println("amountOutMin[1]", amountOutMin)
-	fmt.Println("deadline", deadline)
-	fmt.Println("FromAddress", fromAddress)
-	fmt.Println("path", path)
+	w.log(DebugLogLevel, "buy",
+		"from", transactor.From.Hex(),
+		"token", tokenContractAddress.Hex(),
+		"value", ethValue,
+		"amountsOut", amountOutMin,
+		"gasPrice", gasPrice,
+		"nonce", transactor.Nonce,
+		"deadline", deadline)
 
 	swapTx, SwapExactETHForTokensErr := pancakeRouterInstance.SwapExactETHForTokens(
 		transactor,
@@ -626,16 +864,14 @@ func (w *Web3GolangHelper) Buy(fromAddress common.Address, tokenAddress string,
 		fromAddress,
 		deadline)
 	if SwapExactETHForTokensErr != nil {
-		fmt.Println("SwapExactETHForTokensErr")
-		fmt.Println(SwapExactETHForTokensErr)
+		return "", backend.err(SwapExactETHForTokensErr)
 	}
 
-	fmt.Println(swapTx)
-
 	txHash := swapTx.Hash().Hex()
-	fmt.Println(txHash)
-	genericutils.OpenBrowser("https://testnet.bscscan.com/tx/" + txHash)
+	w.log(InfoLogLevel, "transaction sent", "txHash", swapTx.Hash(), "nonce", swapTx.Nonce(), "from", transactor.From.Hex(), "to", pancakeContractAddress.Hex())
+	w.notifyTxSent(swapTx)
 
+	return txHash, nil
 }
 
 func (w *Web3GolangHelper) BuyV2(fromAddress common.Address, tokenAddress string, value *big.Int, pk string) {
@@ -654,82 +890,74 @@ func (w *Web3GolangHelper) BuyV2(fromAddress common.Address, tokenAddress string
 	buf := &bytes.Buffer{}
 	gob.NewEncoder(buf).Encode(pathString)
 	bs := buf.Bytes()
-	fmt.Printf("%q", bs)
 
 	paddedAmountOutMin := common.LeftPadBytes(value.Bytes(), 32)
-	paddedPathA := common.LeftPadBytes(path[0].Bytes(), 32)
-	paddedPathB := common.LeftPadBytes(path[1].Bytes(), 32)
 	paddedPath := common.LeftPadBytes(bs, 32)
 	paddedTo := common.LeftPadBytes(toAddress.Bytes(), 32)
 	paddedDeadline := common.LeftPadBytes(deadline.Bytes(), 32)
 
-	fmt.Println("paddedAmountOutMin", paddedAmountOutMin)
-	fmt.Println("paddedPathA", paddedPathA)
-	fmt.Println("paddedPathB", paddedPathB)
-	fmt.Println("paddedPath", paddedPath)
-	fmt.Println("paddedTo", paddedTo)
-	fmt.Println("paddedDeadline", paddedDeadline)
-	fmt.Println("paddedAmountOutMin", paddedAmountOutMin)
-	fmt.Println("paddedAmountOutMin", paddedAmountOutMin)
-
 	txData := BuildTxData(methodID, paddedAmountOutMin, paddedPath, paddedTo, paddedDeadline)
 
-	fmt.Println("txData", txData)
-
 	estimateGas := w.EstimateGas(toAddress.Hex(), txData)
 
-	fmt.Println("estimateGas", estimateGas)
+	w.log(DebugLogLevel, "buy",
+		"from", fromAddress.Hex(),
+		"token", tokenAddress,
+		"path", pathString,
+		"value", value,
+		"deadline", deadline,
+		"gasLimit", estimateGas,
+		"data", hexutil.Encode(txData))
 
-	txId, txNonce, err := w.SignAndSendTransaction(toAddress.Hex(), ToWei(value, 18), txData, w.PendingNonce(fromAddress), nil, estimateGas, pk)
+	_, _, err := w.SignAndSendTransaction(toAddress.Hex(), ToWei(value, 18), txData, w.PendingNonce(fromAddress), nil, estimateGas, pk)
 	if err != nil {
-		fmt.Println(err)
+		w.log(ErrorLogLevel, "buy failed", "from", fromAddress.Hex(), "token", tokenAddress, "err", err)
 	}
-
-	fmt.Println(txId)
-	fmt.Println(txNonce)
 }
 
-func (w *Web3GolangHelper) ListenBridgesEventsV2(contractsAddresses []string, out chan<- []chan types.Log) error {
+// ListenBridgesEventsV2 subscribes to the logs of every bridge contract and
+// forwards them to out until the returned subscription is closed.
+func (w *Web3GolangHelper) ListenBridgesEventsV2(contractsAddresses []string, out chan<- types.Log) (ethereum.Subscription, error) {
 
-	var logs []chan types.Log
-	var subs []ethereum.Subscription
+	subscriptionBackend := w.subscriptionBackend()
+	if subscriptionBackend == nil {
+		return nil, errors.New("Nil Web3 Websocket Client")
+	}
 
-	fmt.Println("")
-	fmt.Println(ccolor.YellowString("  --------------------- Contracts Subscriptions ---------------------"))
+	addresses := make([]common.Address, 0, len(contractsAddresses))
 	for i := 0; i < len(contractsAddresses); i++ {
+		addresses = append(addresses, common.HexToAddress(contractsAddresses[i]))
+	}
 
-		contractLog, contractSub, err := w.GenerateContractEventSubscription(contractsAddresses[i])
-		if err != nil {
-			return err
-		}
+	w.log(InfoLogLevel, "subscribing to contract logs", "contracts", contractsAddresses)
 
-		logs = append(logs, contractLog)
-		subs = append(subs, contractSub)
+	logs := make(chan types.Log)
+	sub, err := subscriptionBackend.SubscribeFilterLogs(context.Background(), ethereum.FilterQuery{Addresses: addresses}, logs)
+	if err != nil {
+		w.recordSubscriptionError("logs")
+		return nil, err
+	}
+	w.recordSubscription("logs")
 
-		go func(i int) {
-			fmt.Println(ccolor.MagentaString("    Init Subscription: "), ccolor.YellowString(contractsAddresses[i]))
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
 
-			for {
+		for {
+			select {
+			case err := <-sub.Err():
+				w.recordSubscriptionError("logs")
+				return err
+			case <-quit:
+				return nil
+			case vLog := <-logs:
 				select {
-				case err := <-subs[i].Err():
-					fmt.Println(err)
-					out <- logs
-
-				case vLog := <-logs[i]:
-					//fmt.Println(vLog) // pointer to event log
-					fmt.Println("Data logs")
-					fmt.Println(string(vLog.Data))
-					//fmt.Println("vLog.Address: " + vLog.Address.Hex())
-					fmt.Println("vLog.TxHash: " + vLog.TxHash.Hex())
-					fmt.Println("vLog.BlockNumber: " + strconv.FormatUint(vLog.BlockNumber, 10))
-					fmt.Println("")
-					//out <- vLog.TxHash.Hex()
-					out <- logs
+				case out <- vLog:
+				case <-quit:
+					return nil
 				}
 			}
-		}(i)
-	}
-	return nil
+		}
+	}), nil
 }
 
 /*
@@ -753,37 +981,30 @@ func (w *Web3GolangHelper) SwitchAccount(plainPrivateKey string) {
 }
 */
 
-func (w *Web3GolangHelper) BuildTransactor(fromAddress common.Address, value *big.Int, gasPrice *big.Int, gasLimit uint64) *bind.TransactOpts {
-	privateKey, privateKeyErr := crypto.HexToECDSA("3062979ebcda3efb3bae3919e003f8e3a3597d9244244a13e4a9ff7776221501")
-	//privateKey, privateKeyErr := crypto.HexToECDSA(w.plainPrivateKey)
-
-	if privateKeyErr != nil {
-		fmt.Println(privateKeyErr)
-	}
-
-	transactor, transactOptsErr := bind.NewKeyedTransactorWithChainID(privateKey, w.ChainId())
-
-	if transactOptsErr != nil {
-		fmt.Println(transactOptsErr)
+// BuildTransactor returns transact options signing with pk at its pending
+// nonce.
+func (w *Web3GolangHelper) BuildTransactor(pk string, value *big.Int, gasPrice *big.Int, gasLimit uint64) (*bind.TransactOpts, error) {
+	transactor, err := w.NewTransactor(context.Background(), pk)
+	if err != nil {
+		return nil, err
 	}
 
 	transactor.Value = big.NewInt(0)
-	if value.Uint64() > 0 {
+	if value != nil && value.Sign() > 0 {
 		transactor.Value = value
 	}
 
 	transactor.GasPrice = gasPrice
 	transactor.GasLimit = gasLimit
-	transactor.Nonce = w.PendingNonce(fromAddress)
-	transactor.Context = context.Background()
-	return transactor
+	transactor.Nonce = w.PendingNonce(transactor.From)
+	return transactor, nil
 }
 
 func (w *Web3GolangHelper) Balance(account common.Address) *big.Int {
 	// get current balance
-	balance, balanceErr := w.httpClient.BalanceAt(context.Background(), account, nil)
+	balance, balanceErr := w.selectClient().BalanceAt(context.Background(), account, nil)
 	if balanceErr != nil {
-		fmt.Println(balanceErr)
+		w.log(WarnLogLevel, "get balance failed", "address", account.Hex(), "err", balanceErr)
 	}
 
 	return balance
@@ -846,46 +1067,40 @@ func GeneratePath(tokenAContractPlainAddress string, tokenBContractPlainAddress
 	return path
 }
 
+// CancelTransaction is Web3GolangHelper.CancelTransaction on client.
 func CancelTransaction(client *ethclient.Client, transaction *types.Transaction, privateKey *ecdsa.PrivateKey) (*types.Transaction, error) {
-	value := big.NewInt(0)
+	helper := &Web3GolangHelper{httpClient: client, accounts: make([]*common.Address, 0), feePriority: StandardFeePriority}
+	return helper.CancelTransaction(context.Background(), transaction, privateKey)
+}
 
-	// generate public key and address from private key
-	publicKey := privateKey.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		log.Fatal("error casting public key to ECDSA")
-	}
+// CancelTransaction replaces transaction by an empty transfer to the sender
+// paying 10% more gas, see CancelPendingTransaction to cancel by hash.
+func (w *Web3GolangHelper) CancelTransaction(ctx context.Context, transaction *types.Transaction, privateKey *ecdsa.PrivateKey) (*types.Transaction, error) {
+	value := big.NewInt(0)
 
-	// generate address from public key
-	address := crypto.PubkeyToAddress(*publicKeyECDSA)
+	// generate address from private key
+	address := crypto.PubkeyToAddress(privateKey.PublicKey)
 
 	var data []byte
 
-	fmt.Println(transaction.GasPrice())
-
 	newGasPrice := big.NewInt(0).Add(transaction.GasPrice(), big.NewInt(0).Div(big.NewInt(0).Mul(transaction.GasPrice(), big.NewInt(10)), big.NewInt(100)))
-	fmt.Println(newGasPrice)
 	tx := types.NewTransaction(transaction.Nonce(), address, value, transaction.Gas(), newGasPrice, data)
 
-	// get chain id
-	chainID, chainIDErr := client.ChainID(context.Background())
-	if chainIDErr != nil {
-		log.Fatal(chainIDErr)
-		return nil, chainIDErr
+	chainID, err := w.cachedChainID(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), privateKey)
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(chainID), privateKey)
 	if err != nil {
-		log.Fatal(err)
 		return nil, err
 	}
 
-	err = client.SendTransaction(context.Background(), signedTx)
-	if err != nil {
-		log.Fatal(err)
+	if err := w.sendTransaction(ctx, signedTx); err != nil {
 		return nil, err
 	}
 
+	w.notifyTxSent(signedTx)
 	return signedTx, nil
 }
 
@@ -904,35 +1119,39 @@ func (w *Web3GolangHelper) getTokenPairs(token *models.EventsCatched) string {
 
 func (w *Web3GolangHelper) GetReserves(pairAddress string) Reserve {
 
-	pairInstance, instanceErr := pancakePair.NewPancake(common.HexToAddress(pairAddress), w.HttpClient())
+	pairInstance, instanceErr := pancakePair.NewIPancakePair(common.HexToAddress(pairAddress), w.selectClient())
 	if instanceErr != nil {
-		fmt.Println(instanceErr)
+		w.log(ErrorLogLevel, "load pair failed", "pair", pairAddress, "err", instanceErr)
 	}
 
 	reserves, getReservesErr := pairInstance.GetReserves(nil)
 	if getReservesErr != nil {
-		fmt.Println(getReservesErr)
+		w.log(WarnLogLevel, "get reserves failed", "pair", pairAddress, "err", getReservesErr)
 	}
 
 	return reserves
 }
 
-func (w *Web3GolangHelper) GetPair(tokenAddress string) string {
+// GetPair returns the pair of tokenAddress and the wrapped native token on the
+// first exchange of the connected network.
+func (w *Web3GolangHelper) GetPair(tokenAddress string) (string, error) {
 
-	factoryInstance, instanceErr := pancakeFactory.NewPancake(common.HexToAddress("0xB7926C0430Afb07AA7DEfDE6DA862aE0Bde767bc"), w.HttpClient())
-	if instanceErr != nil {
-		fmt.Println(instanceErr)
+	_, factoryAddress, wBnbContractAddress, err := w.defaultDex()
+	if err != nil {
+		return "", err
 	}
 
-	wBnbContractAddress := "0xae13d989daC2f0dEbFf460aC112a837C89BAa7cd"
+	factoryInstance, instanceErr := pancakeFactory.NewIPancakeFactory(factoryAddress, w.selectClient())
+	if instanceErr != nil {
+		return "", instanceErr
+	}
 
-	lpPairAddress, getPairErr := factoryInstance.GetPair(nil, common.HexToAddress(wBnbContractAddress), common.HexToAddress(tokenAddress))
+	lpPairAddress, getPairErr := factoryInstance.GetPair(nil, wBnbContractAddress, common.HexToAddress(tokenAddress))
 	if getPairErr != nil {
-		fmt.Println(getPairErr)
+		return "", getPairErr
 	}
 
-	return lpPairAddress.Hex()
-
+	return lpPairAddress.Hex(), nil
 }
 
 // IsValidAddress validate hex address
@@ -1048,26 +1267,20 @@ func ValidateAddress(address string) bool {
 	return re.MatchString(address)
 }
 
-// SigRSV signatures R S V returned as arrays
+// SigRSV splits a 65 byte signature, as []byte or hex string, into R, S and
+// V, with V as 27 or 28 whichever form it was signed with. Invalid
+// signatures return zero values.
 func SigRSV(isig interface{}) ([32]byte, [32]byte, uint8) {
-	var sig []byte
-	switch v := isig.(type) {
-	case []byte:
-		sig = v
-	case string:
-		sig, _ = hexutil.Decode(v)
+	sig, err := signatureBytes(isig)
+	if err != nil || len(sig) != crypto.SignatureLength {
+		return [32]byte{}, [32]byte{}, 0
 	}
 
-	sigstr := common.Bytes2Hex(sig)
-	rS := sigstr[0:64]
-	sS := sigstr[64:128]
 	R := [32]byte{}
 	S := [32]byte{}
-	copy(R[:], common.FromHex(rS))
-	copy(S[:], common.FromHex(sS))
-	vStr := sigstr[128:130]
-	vI, _ := strconv.Atoi(vStr)
-	V := uint8(vI + 27)
+	copy(R[:], sig[0:32])
+	copy(S[:], sig[32:64])
+	V := normalizeV(sig[crypto.RecoveryIDOffset])
 
 	return R, S, V
 }
@@ -1098,82 +1311,8 @@ func GenerateAddressFromPlainPrivateKey(pk string) (common.Address, *ecdsa.Priva
 	return crypto.PubkeyToAddress(*publicKeyECDSA), privateKey, nil
 }
 
-func getWallets() {
-	wallets := make([]*Account, 0)
-
-	wPath := "./wallets"
-	files, err := ioutil.ReadDir(wPath)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	for _, file := range files {
-		fileName := file.Name()
-		fmt.Println("fileName", fileName)
-
-		wallet := new(Account)
-
-		// Open our jsonFile
-		jsonFile, _ := os.Open(wPath + "/" + fileName)
-		byteValue, _ := ioutil.ReadAll(jsonFile)
-		json.Unmarshal(byteValue, &wallet)
-		fmt.Println(wallet)
-		wallets = append(wallets, wallet)
-	}
-
-	fmt.Println(wallets)
-}
-
-func qrr() {
-
-	config := qrterminal.Config{
-		Level:     qrterminal.M,
-		Writer:    os.Stdout,
-		BlackChar: qrterminal.WHITE,
-		WhiteChar: qrterminal.BLACK,
-		QuietZone: 1,
-	}
-	qrterminal.GenerateWithConfig("https://github.com/mdp/qrterminal", config)
-
-	err := qrcode.WriteColorFile("singana", qrcode.Medium, 256, color.Black, color.White, "secondfile.png")
-	if err != nil {
-		fmt.Printf("Sorry couldn't create qrcode:,%v", err)
-
-	}
-
-}
-
-func GenerateWallet() {
-
-	privateKey, err := crypto.GenerateKey()
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	privateKeyBytes := crypto.FromECDSA(privateKey)
-	fmt.Println(hexutil.Encode(privateKeyBytes)[2:]) // fad9c8855b740a0b7ed4c221dbad0f33a83a49cad6b3fe8d5817ac83d38b6a19
-
-	publicKey := privateKey.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		log.Fatal("cannot assert type: publicKey is not of type *ecdsa.PublicKey")
-	}
-
-	publicKeyBytes := crypto.FromECDSAPub(publicKeyECDSA)
-	fmt.Println(hexutil.Encode(publicKeyBytes)[4:]) // 9a7df67f79246283fdc93af76d4f8cdd62c4886e8cd870944e817dd0b97934fdd7719d0810951e03418205868a5c1b40b192451367f28e0088dd75e15de40c05
-
-	address := crypto.PubkeyToAddress(*publicKeyECDSA).Hex()
-	fmt.Println(address) // 0x96216849c49358B10257cb55b28eA603c874b05E
-
-	hash := sha3.NewLegacyKeccak256()
-	hash.Write(publicKeyBytes[1:])
-	fmt.Println(hexutil.Encode(hash.Sum(nil)[12:])) // 0x96216849c49358b10257cb55b28ea603c874b05e
-
-	wallet := Account{
-		PublicKey:  address,
-		PrivateKey: hexutil.Encode(privateKeyBytes)[2:],
-	}
-
-	file, _ := json.MarshalIndent(wallet, "", " ")
-	_ = ioutil.WriteFile("wallets/"+address+".json", file, 0644)
+// GenerateWallet creates an account in the wallets directory, see
+// WalletStore.Create.
+func GenerateWallet() (*Account, error) {
+	return NewWalletStore("wallets").Create()
 }
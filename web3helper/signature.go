@@ -0,0 +1,145 @@
+package web3helper
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// signHash signs a 32 byte hash with pk and returns the 65 byte signature
+// with V as 27 or 28, the form wallets produce.
+func signHash(hash []byte, pk string) ([]byte, error) {
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(pk, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	return signHashWithKey(hash, privateKey)
+}
+
+func signHashWithKey(hash []byte, privateKey *ecdsa.PrivateKey) ([]byte, error) {
+	signature, err := crypto.Sign(hash, privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signature[crypto.RecoveryIDOffset] = normalizeV(signature[crypto.RecoveryIDOffset])
+	return signature, nil
+}
+
+// recoverAddress returns the signer of hash, accepting V as 0/1 or 27/28.
+func recoverAddress(hash []byte, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, ErrInvalidSignature
+	}
+
+	normalized := make([]byte, crypto.SignatureLength)
+	copy(normalized, signature)
+	if normalized[crypto.RecoveryIDOffset] >= 27 {
+		normalized[crypto.RecoveryIDOffset] -= 27
+	}
+	if normalized[crypto.RecoveryIDOffset] > 1 {
+		return common.Address{}, ErrInvalidSignature
+	}
+
+	publicKey, err := crypto.SigToPub(hash, normalized)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*publicKey), nil
+}
+
+// normalizeV returns the recovery byte of a signature as 27 or 28.
+func normalizeV(v byte) byte {
+	if v < 27 {
+		return v + 27
+	}
+	return v
+}
+
+// signatureBytes returns a signature given as []byte or hex string.
+func signatureBytes(signature interface{}) ([]byte, error) {
+	switch v := signature.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		decoded, err := hexutil.Decode(v)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+		}
+		return decoded, nil
+	}
+	return nil, fmt.Errorf("%w: unsupported type %T", ErrInvalidSignature, signature)
+}
+
+// messageBytes returns a message given as []byte or string. Strings in 0x
+// hex are decoded like personal_sign does, other strings are signed as text.
+func messageBytes(message interface{}) ([]byte, error) {
+	switch v := message.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		if has0xPrefix(v) {
+			if decoded, err := hexutil.Decode(v); err == nil {
+				return decoded, nil
+			}
+		}
+		return []byte(v), nil
+	}
+	return nil, fmt.Errorf("unsupported message type %T", message)
+}
+
+func has0xPrefix(s string) bool {
+	return len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X')
+}
+
+// HashMessage returns the EIP-191 hash of message,
+// keccak256("\x19Ethereum Signed Message:\n" || len(message) || message).
+func HashMessage(message interface{}) (common.Hash, error) {
+	data, err := messageBytes(message)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(accounts.TextHash(data)), nil
+}
+
+// SignMessage signs message as personal_sign does, V as 27 or 28. message is
+// []byte or a string, see HashMessage.
+func SignMessage(message interface{}, pk string) ([]byte, error) {
+	hash, err := HashMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	return signHash(hash.Bytes(), pk)
+}
+
+// RecoverMessageSigner returns the address that signed message. signature is
+// []byte or a hex string, with V as 0/1 or 27/28.
+func RecoverMessageSigner(message interface{}, signature interface{}) (common.Address, error) {
+	hash, err := HashMessage(message)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	sig, err := signatureBytes(signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return recoverAddress(hash.Bytes(), sig)
+}
+
+// VerifyMessage reports whether address signed message.
+func VerifyMessage(message interface{}, signature interface{}, address common.Address) (bool, error) {
+	signer, err := RecoverMessageSigner(message, signature)
+	if err != nil {
+		return false, err
+	}
+	return signer == address, nil
+}
@@ -0,0 +1,323 @@
+// Package siwe builds, parses and verifies Sign-In with Ethereum (EIP-4361)
+// messages, the text wallets sign to log in to a site.
+//
+//	message, err := siwe.NewMessage("dashboard.example.com", address, "https://dashboard.example.com/login", network.ChainID)
+//	message.Statement = "Log in to the dashboard."
+//	message.ExpirationTime = time.Now().Add(10 * time.Minute)
+//
+//	// the wallet signs message.String() with personal_sign
+//
+//	signed, err := siwe.Verify(text, signature, siwe.VerifyOptions{
+//		Domain:  "dashboard.example.com",
+//		Network: network,
+//		Nonce:   message.Nonce,
+//	})
+package siwe
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/nikola43/web3golanghelper/web3helper"
+)
+
+var ErrInvalidMessage = errors.New("invalid sign-in message")
+
+const (
+	// Version is the only version of the message format.
+	Version = "1"
+
+	nonceAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	nonceLength   = 17
+	minNonceLen   = 8
+
+	headerSuffix = " wants you to sign in with your Ethereum account:"
+)
+
+// Message is an EIP-4361 message. Times left zero are omitted.
+type Message struct {
+	// Scheme is the URI scheme of the site, optional.
+	Scheme  string
+	Domain  string
+	Address common.Address
+
+	// Statement is a line of text shown to the user, optional.
+	Statement string
+
+	URI     string
+	Version string
+	ChainID uint64
+	Nonce   string
+
+	IssuedAt       time.Time
+	ExpirationTime time.Time
+	NotBefore      time.Time
+
+	RequestID string
+	Resources []string
+}
+
+// NewMessage returns a message of address signing in to domain, issued now
+// with a random nonce.
+func NewMessage(domain string, address common.Address, uri string, chainID uint64) (*Message, error) {
+	nonce, err := GenerateNonce()
+	if err != nil {
+		return nil, err
+	}
+
+	message := &Message{
+		Domain:   domain,
+		Address:  address,
+		URI:      uri,
+		Version:  Version,
+		ChainID:  chainID,
+		Nonce:    nonce,
+		IssuedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	if err := message.Validate(); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// GenerateNonce returns a random alphanumeric nonce.
+func GenerateNonce() (string, error) {
+	max := big.NewInt(int64(len(nonceAlphabet)))
+
+	nonce := make([]byte, nonceLength)
+	for i := range nonce {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		nonce[i] = nonceAlphabet[n.Int64()]
+	}
+	return string(nonce), nil
+}
+
+// Validate checks the fields required by EIP-4361 and their syntax.
+func (m *Message) Validate() error {
+	switch {
+	case m.Domain == "" || strings.ContainsAny(m.Domain, " /\n"):
+		return fmt.Errorf("%w: domain %q", ErrInvalidMessage, m.Domain)
+	case m.Address == (common.Address{}):
+		return fmt.Errorf("%w: no address", ErrInvalidMessage)
+	case strings.Contains(m.Statement, "\n"):
+		return fmt.Errorf("%w: statement spans several lines", ErrInvalidMessage)
+	case m.Version != Version:
+		return fmt.Errorf("%w: version %q", ErrInvalidMessage, m.Version)
+	case m.ChainID == 0:
+		return fmt.Errorf("%w: no chain id", ErrInvalidMessage)
+	case !validNonce(m.Nonce):
+		return fmt.Errorf("%w: nonce must be at least %d letters or digits", ErrInvalidMessage, minNonceLen)
+	case m.IssuedAt.IsZero():
+		return fmt.Errorf("%w: no issued at time", ErrInvalidMessage)
+	}
+
+	if uri, err := url.Parse(m.URI); err != nil || uri.Scheme == "" {
+		return fmt.Errorf("%w: uri %q", ErrInvalidMessage, m.URI)
+	}
+	for _, resource := range m.Resources {
+		if uri, err := url.Parse(resource); err != nil || uri.Scheme == "" {
+			return fmt.Errorf("%w: resource %q", ErrInvalidMessage, resource)
+		}
+	}
+	return nil
+}
+
+func validNonce(nonce string) bool {
+	if len(nonce) < minNonceLen {
+		return false
+	}
+	for _, c := range nonce {
+		if !strings.ContainsRune(nonceAlphabet, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the text the wallet signs.
+func (m *Message) String() string {
+	var b strings.Builder
+
+	if m.Scheme != "" {
+		b.WriteString(m.Scheme + "://")
+	}
+	b.WriteString(m.Domain + headerSuffix + "\n")
+	b.WriteString(m.Address.Hex() + "\n")
+	b.WriteString("\n")
+	if m.Statement != "" {
+		b.WriteString(m.Statement + "\n")
+	}
+	b.WriteString("\n")
+
+	b.WriteString("URI: " + m.URI + "\n")
+	b.WriteString("Version: " + m.Version + "\n")
+	b.WriteString("Chain ID: " + strconv.FormatUint(m.ChainID, 10) + "\n")
+	b.WriteString("Nonce: " + m.Nonce + "\n")
+	b.WriteString("Issued At: " + formatTime(m.IssuedAt))
+	if !m.ExpirationTime.IsZero() {
+		b.WriteString("\nExpiration Time: " + formatTime(m.ExpirationTime))
+	}
+	if !m.NotBefore.IsZero() {
+		b.WriteString("\nNot Before: " + formatTime(m.NotBefore))
+	}
+	if m.RequestID != "" {
+		b.WriteString("\nRequest ID: " + m.RequestID)
+	}
+	if len(m.Resources) > 0 {
+		b.WriteString("\nResources:")
+		for _, resource := range m.Resources {
+			b.WriteString("\n- " + resource)
+		}
+	}
+	return b.String()
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// Sign signs the message with pk as personal_sign does, as a wallet would.
+func (m *Message) Sign(pk string) ([]byte, error) {
+	return web3helper.SignMessage([]byte(m.String()), pk)
+}
+
+// ParseMessage decodes the text of a message and validates it.
+func ParseMessage(text string) (*Message, error) {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	p := &parser{lines: lines}
+
+	m := new(Message)
+
+	header := p.next()
+	if !strings.HasSuffix(header, headerSuffix) {
+		return nil, fmt.Errorf("%w: missing header", ErrInvalidMessage)
+	}
+	m.Domain = strings.TrimSuffix(header, headerSuffix)
+	if i := strings.Index(m.Domain, "://"); i >= 0 {
+		m.Scheme, m.Domain = m.Domain[:i], m.Domain[i+3:]
+	}
+
+	address := p.next()
+	if !common.IsHexAddress(address) || common.HexToAddress(address).Hex() != address {
+		return nil, fmt.Errorf("%w: address %q is not checksummed", ErrInvalidMessage, address)
+	}
+	m.Address = common.HexToAddress(address)
+
+	if p.next() != "" {
+		return nil, fmt.Errorf("%w: missing empty line after the address", ErrInvalidMessage)
+	}
+	if line := p.next(); line != "" {
+		m.Statement = line
+		if p.next() != "" {
+			return nil, fmt.Errorf("%w: missing empty line after the statement", ErrInvalidMessage)
+		}
+	}
+
+	var err error
+	if m.URI, err = p.field("URI", true); err != nil {
+		return nil, err
+	}
+	if m.Version, err = p.field("Version", true); err != nil {
+		return nil, err
+	}
+
+	chainID, err := p.field("Chain ID", true)
+	if err != nil {
+		return nil, err
+	}
+	if m.ChainID, err = strconv.ParseUint(chainID, 10, 64); err != nil {
+		return nil, fmt.Errorf("%w: chain id %q", ErrInvalidMessage, chainID)
+	}
+
+	if m.Nonce, err = p.field("Nonce", true); err != nil {
+		return nil, err
+	}
+	if m.IssuedAt, err = p.timeField("Issued At", true); err != nil {
+		return nil, err
+	}
+	if m.ExpirationTime, err = p.timeField("Expiration Time", false); err != nil {
+		return nil, err
+	}
+	if m.NotBefore, err = p.timeField("Not Before", false); err != nil {
+		return nil, err
+	}
+	if m.RequestID, err = p.field("Request ID", false); err != nil {
+		return nil, err
+	}
+
+	if p.peek() == "Resources:" {
+		p.next()
+		for p.more() && strings.HasPrefix(p.peek(), "- ") {
+			m.Resources = append(m.Resources, strings.TrimPrefix(p.next(), "- "))
+		}
+	}
+
+	if p.more() {
+		return nil, fmt.Errorf("%w: unexpected line %q", ErrInvalidMessage, p.peek())
+	}
+
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// parser reads the lines of a message in order.
+type parser struct {
+	lines []string
+	pos   int
+}
+
+func (p *parser) more() bool {
+	return p.pos < len(p.lines)
+}
+
+func (p *parser) peek() string {
+	if !p.more() {
+		return ""
+	}
+	return p.lines[p.pos]
+}
+
+func (p *parser) next() string {
+	line := p.peek()
+	p.pos++
+	return line
+}
+
+// field returns the value of the "name: value" line, "" when an optional
+// field is absent.
+func (p *parser) field(name string, required bool) (string, error) {
+	prefix := name + ": "
+	if p.more() && strings.HasPrefix(p.peek(), prefix) {
+		return strings.TrimPrefix(p.next(), prefix), nil
+	}
+	if required {
+		return "", fmt.Errorf("%w: missing %s", ErrInvalidMessage, name)
+	}
+	return "", nil
+}
+
+func (p *parser) timeField(name string, required bool) (time.Time, error) {
+	value, err := p.field(name, required)
+	if err != nil || value == "" {
+		return time.Time{}, err
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s %q", ErrInvalidMessage, name, value)
+	}
+	return t, nil
+}
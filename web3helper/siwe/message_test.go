@@ -0,0 +1,92 @@
+package siwe
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// specMessage is the example message of EIP-4361.
+const specMessage = `service.invalid wants you to sign in with your Ethereum account:
+0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2
+
+I accept the ServiceOrg Terms of Service: https://service.invalid/tos
+
+URI: https://service.invalid/login
+Version: 1
+Chain ID: 1
+Nonce: 32891756
+Issued At: 2021-09-30T16:25:24Z
+Resources:
+- ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/
+- https://example.com/my-web2-claim.json`
+
+func TestParseMessage(t *testing.T) {
+	m, err := ParseMessage(specMessage)
+	if err != nil {
+		t.Fatalf("ParseMessage: %v", err)
+	}
+
+	switch {
+	case m.Domain != "service.invalid":
+		t.Errorf("Domain = %q", m.Domain)
+	case m.Address != common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"):
+		t.Errorf("Address = %s", m.Address.Hex())
+	case m.Statement != "I accept the ServiceOrg Terms of Service: https://service.invalid/tos":
+		t.Errorf("Statement = %q", m.Statement)
+	case m.URI != "https://service.invalid/login":
+		t.Errorf("URI = %q", m.URI)
+	case m.Version != Version || m.ChainID != 1 || m.Nonce != "32891756":
+		t.Errorf("Version, ChainID, Nonce = %q, %d, %q", m.Version, m.ChainID, m.Nonce)
+	case !m.IssuedAt.Equal(time.Date(2021, 9, 30, 16, 25, 24, 0, time.UTC)):
+		t.Errorf("IssuedAt = %s", m.IssuedAt)
+	case len(m.Resources) != 2 || m.Resources[1] != "https://example.com/my-web2-claim.json":
+		t.Errorf("Resources = %q", m.Resources)
+	}
+}
+
+func TestMessageRoundTrip(t *testing.T) {
+	parsed, err := ParseMessage(specMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text := parsed.String(); text != specMessage {
+		t.Fatalf("String() =\n%s\nwant\n%s", text, specMessage)
+	}
+
+	m, err := NewMessage("dashboard.example.com", common.HexToAddress("0x00000000000000000000000000000000000000aa"), "https://dashboard.example.com/login", 56)
+	if err != nil {
+		t.Fatalf("NewMessage: %v", err)
+	}
+	m.Scheme = "https"
+	m.ExpirationTime = m.IssuedAt.Add(10 * time.Minute)
+	m.NotBefore = m.IssuedAt
+	m.RequestID = "request-1"
+
+	again, err := ParseMessage(m.String())
+	if err != nil {
+		t.Fatalf("ParseMessage(%q): %v", m.String(), err)
+	}
+	if again.String() != m.String() || again.Scheme != "https" || !again.ExpirationTime.Equal(m.ExpirationTime) {
+		t.Fatalf("round trip changed the message:\n%s\n%s", again, m)
+	}
+}
+
+func TestParseMessageInvalid(t *testing.T) {
+	cases := map[string]string{
+		"header":   strings.Replace(specMessage, "wants you", "asks you", 1),
+		"checksum": strings.Replace(specMessage, "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2", "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2", 1),
+		"version":  strings.Replace(specMessage, "Version: 1", "Version: 2", 1),
+		"nonce":    strings.Replace(specMessage, "Nonce: 32891756", "Nonce: 123", 1),
+		"time":     strings.Replace(specMessage, "2021-09-30T16:25:24Z", "yesterday", 1),
+		"trailing": specMessage + "\nextra",
+	}
+	for name, text := range cases {
+		if _, err := ParseMessage(text); !errors.Is(err, ErrInvalidMessage) {
+			t.Errorf("%s: ParseMessage = %v, want ErrInvalidMessage", name, err)
+		}
+	}
+}
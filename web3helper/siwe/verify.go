@@ -0,0 +1,91 @@
+package siwe
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nikola43/web3golanghelper/web3helper"
+)
+
+var (
+	// ErrMissingOption is returned when VerifyOptions lacks the domain or
+	// nonce, without which any signed message would be accepted.
+	ErrMissingOption = errors.New("verify options need the domain and nonce")
+
+	ErrSignerMismatch = errors.New("message not signed by its address")
+	ErrDomainMismatch = errors.New("message for another domain")
+	ErrNonceMismatch  = errors.New("message nonce does not match")
+	ErrExpired        = errors.New("message expired")
+	ErrNotYetValid    = errors.New("message not yet valid")
+)
+
+// VerifyOptions are the values a message must match. Domain and Nonce are
+// required, a nil Network accepts any chain.
+type VerifyOptions struct {
+	// Domain is the domain the user signs in to, e.g. "dashboard.example.com".
+	Domain string
+
+	// Network is the chain the message must be for.
+	Network *web3helper.EVMNetwork
+
+	// Nonce is the nonce issued to the user for this sign-in.
+	Nonce string
+
+	// Time is when the message is checked, now when zero.
+	Time time.Time
+}
+
+// Verify parses text, checks it was signed by its address with signature,
+// []byte or a hex string, and validates its fields against opts. It returns
+// the parsed message. Signatures of contract wallets (EIP-1271) are not
+// supported.
+func Verify(text string, signature interface{}, opts VerifyOptions) (*Message, error) {
+	message, err := ParseMessage(text)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := message.VerifyFields(opts); err != nil {
+		return nil, err
+	}
+
+	// the signature covers the text as received, not its re-encoding
+	signer, err := web3helper.RecoverMessageSigner([]byte(text), signature)
+	if err != nil {
+		return nil, err
+	}
+	if signer != message.Address {
+		return nil, fmt.Errorf("%w: signed by %s, expected %s", ErrSignerMismatch, signer.Hex(), message.Address.Hex())
+	}
+	return message, nil
+}
+
+// VerifyFields checks the domain, chain, nonce and validity period of m.
+func (m *Message) VerifyFields(opts VerifyOptions) error {
+	if opts.Domain == "" || opts.Nonce == "" {
+		return ErrMissingOption
+	}
+
+	if m.Domain != opts.Domain {
+		return fmt.Errorf("%w: %s, expected %s", ErrDomainMismatch, m.Domain, opts.Domain)
+	}
+	if opts.Network != nil && m.ChainID != opts.Network.ChainID {
+		return fmt.Errorf("%w: message for chain %d, %s is %d", web3helper.ErrChainIDMismatch, m.ChainID, opts.Network.Name, opts.Network.ChainID)
+	}
+	if m.Nonce != opts.Nonce {
+		return ErrNonceMismatch
+	}
+
+	now := opts.Time
+	if now.IsZero() {
+		now = time.Now()
+	}
+	if !m.ExpirationTime.IsZero() && !now.Before(m.ExpirationTime) {
+		return fmt.Errorf("%w at %s", ErrExpired, formatTime(m.ExpirationTime))
+	}
+	if !m.NotBefore.IsZero() && now.Before(m.NotBefore) {
+		return fmt.Errorf("%w before %s", ErrNotYetValid, formatTime(m.NotBefore))
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package siwe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/nikola43/web3golanghelper/web3helper"
+)
+
+const testDomain = "dashboard.example.com"
+
+// signedMessage returns a message of a new key, its text and signature.
+func signedMessage(t *testing.T, edit func(m *Message)) (*Message, string, []byte) {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewMessage(testDomain, crypto.PubkeyToAddress(key.PublicKey), "https://dashboard.example.com/login", 56)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if edit != nil {
+		edit(m)
+	}
+
+	signature, err := m.Sign(common.Bytes2Hex(crypto.FromECDSA(key)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m, m.String(), signature
+}
+
+func TestVerify(t *testing.T) {
+	m, text, signature := signedMessage(t, nil)
+
+	verified, err := Verify(text, signature, VerifyOptions{
+		Domain:  testDomain,
+		Network: &web3helper.EVMNetwork{Name: "bsc", ChainID: 56},
+		Nonce:   m.Nonce,
+	})
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if verified.Address != m.Address {
+		t.Fatalf("verified %s, want %s", verified.Address.Hex(), m.Address.Hex())
+	}
+}
+
+func TestVerifyRequiresDomainAndNonce(t *testing.T) {
+	m, text, signature := signedMessage(t, nil)
+
+	for name, opts := range map[string]VerifyOptions{
+		"domain": {Nonce: m.Nonce},
+		"nonce":  {Domain: testDomain},
+	} {
+		if _, err := Verify(text, signature, opts); !errors.Is(err, ErrMissingOption) {
+			t.Errorf("without %s: Verify = %v, want ErrMissingOption", name, err)
+		}
+	}
+}
+
+func TestVerifyMismatch(t *testing.T) {
+	m, text, signature := signedMessage(t, nil)
+	_, _, otherSignature := signedMessage(t, nil)
+
+	cases := []struct {
+		name      string
+		opts      VerifyOptions
+		signature []byte
+		want      error
+	}{
+		{"domain", VerifyOptions{Domain: "evil.example.com", Nonce: m.Nonce}, signature, ErrDomainMismatch},
+		{"nonce", VerifyOptions{Domain: testDomain, Nonce: "otherNonce123"}, signature, ErrNonceMismatch},
+		{"chain", VerifyOptions{Domain: testDomain, Nonce: m.Nonce, Network: &web3helper.EVMNetwork{Name: "eth", ChainID: 1}}, signature, web3helper.ErrChainIDMismatch},
+		{"signer", VerifyOptions{Domain: testDomain, Nonce: m.Nonce}, otherSignature, ErrSignerMismatch},
+	}
+	for _, c := range cases {
+		if _, err := Verify(text, c.signature, c.opts); !errors.Is(err, c.want) {
+			t.Errorf("%s: Verify = %v, want %v", c.name, err, c.want)
+		}
+	}
+}
+
+func TestVerifyValidityPeriod(t *testing.T) {
+	issuedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	m, text, signature := signedMessage(t, func(m *Message) {
+		m.IssuedAt = issuedAt
+		m.NotBefore = issuedAt.Add(time.Minute)
+		m.ExpirationTime = issuedAt.Add(10 * time.Minute)
+	})
+
+	at := func(t time.Time) VerifyOptions {
+		return VerifyOptions{Domain: testDomain, Nonce: m.Nonce, Time: t}
+	}
+
+	if _, err := Verify(text, signature, at(issuedAt)); !errors.Is(err, ErrNotYetValid) {
+		t.Errorf("before NotBefore: Verify = %v, want ErrNotYetValid", err)
+	}
+	if _, err := Verify(text, signature, at(issuedAt.Add(5*time.Minute))); err != nil {
+		t.Errorf("within the period: Verify = %v", err)
+	}
+	if _, err := Verify(text, signature, at(m.ExpirationTime)); !errors.Is(err, ErrExpired) {
+		t.Errorf("at ExpirationTime: Verify = %v, want ErrExpired", err)
+	}
+	if _, err := Verify(text, signature, VerifyOptions{Domain: testDomain, Nonce: m.Nonce}); !errors.Is(err, ErrExpired) {
+		t.Errorf("now: Verify = %v, want ErrExpired", err)
+	}
+}
@@ -0,0 +1,256 @@
+package web3helper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// ErrPendingTxUnsupported is returned by SubscribePendingTransactions on
+// helpers without a websocket endpoint or a PendingTxBackend.
+var ErrPendingTxUnsupported = errors.New("pending transactions need a websocket endpoint or a PendingTxBackend")
+
+// PendingTxBackend is a Backend streaming the hashes of new pending
+// transactions, like gethclient does over websockets. Backends passed to
+// NewWeb3GolangHelperFromBackend implement it to serve
+// SubscribePendingTransactions, see pancaketest.
+type PendingTxBackend interface {
+	SubscribePendingTransactions(ctx context.Context, ch chan<- common.Hash) (ethereum.Subscription, error)
+}
+
+// gethPendingTxs adapts gethclient, whose subscription is an
+// *rpc.ClientSubscription, to PendingTxBackend.
+type gethPendingTxs struct {
+	client *gethclient.Client
+}
+
+func (g gethPendingTxs) SubscribePendingTransactions(ctx context.Context, ch chan<- common.Hash) (ethereum.Subscription, error) {
+	sub, err := g.client.SubscribePendingTransactions(ctx, ch)
+	if err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// BlockEvent is emitted for every new chain head. Block and Receipts are only
+// filled when requested in BlockSubscriptionOptions.
+type BlockEvent struct {
+	Header   *types.Header
+	Block    *types.Block
+	Receipts []*types.Receipt
+}
+
+type BlockSubscriptionOptions struct {
+	FetchBlock    bool
+	FetchReceipts bool
+}
+
+// PendingTxFilter selects pending transactions by recipient and 4-byte method
+// selector. Empty lists match everything.
+type PendingTxFilter struct {
+	To        []common.Address
+	Selectors [][4]byte
+}
+
+// MethodSelector returns the 4-byte selector of a function signature such as
+// "swapExactETHForTokens(uint256,address[],address,uint256)".
+func MethodSelector(signature string) [4]byte {
+	var selector [4]byte
+	copy(selector[:], crypto.Keccak256([]byte(signature))[:4])
+	return selector
+}
+
+func (f PendingTxFilter) Match(tx *types.Transaction) bool {
+	if len(f.To) > 0 {
+		if tx.To() == nil {
+			return false
+		}
+
+		found := false
+		for _, to := range f.To {
+			if *tx.To() == to {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(f.Selectors) > 0 {
+		if len(tx.Data()) < 4 {
+			return false
+		}
+
+		found := false
+		for _, selector := range f.Selectors {
+			if bytes.Equal(tx.Data()[:4], selector[:]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SubscribeNewBlocks streams new chain heads into out. Heads whose block or
+// receipts cannot be fetched are logged and skipped. The returned
+// subscription stops the stream when unsubscribed, when ctx is done or when
+// the upstream subscription fails.
+func (w *Web3GolangHelper) SubscribeNewBlocks(ctx context.Context, opts BlockSubscriptionOptions, out chan<- *BlockEvent) (ethereum.Subscription, error) {
+
+	subscriptionBackend := w.subscriptionBackend()
+	if subscriptionBackend == nil {
+		return nil, errors.New("Nil Web3 Websocket Client")
+	}
+
+	headers := make(chan *types.Header)
+	headSub, err := subscriptionBackend.SubscribeNewHead(ctx, headers)
+	if err != nil {
+		w.recordSubscriptionError("newHeads")
+		return nil, err
+	}
+	w.recordSubscription("newHeads")
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer headSub.Unsubscribe()
+
+		for {
+			select {
+			case err := <-headSub.Err():
+				w.recordSubscriptionError("newHeads")
+				return err
+			case <-quit:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			case header := <-headers:
+				w.recordEventLag("newHeads", header.Time)
+
+				// a node may lag the head it announced, the next one is tried
+				blockEvent, err := w.buildBlockEvent(ctx, header, opts)
+				if err != nil {
+					w.log(WarnLogLevel, "skipped new head", "block", header.Number, "hash", header.Hash(), "err", err)
+					w.countSubscriptionError("newHeads")
+					continue
+				}
+
+				select {
+				case out <- blockEvent:
+				case <-quit:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+	}), nil
+}
+
+func (w *Web3GolangHelper) buildBlockEvent(ctx context.Context, header *types.Header, opts BlockSubscriptionOptions) (*BlockEvent, error) {
+	blockEvent := &BlockEvent{Header: header}
+
+	if !opts.FetchBlock && !opts.FetchReceipts {
+		return blockEvent, nil
+	}
+
+	block, err := w.selectClient().BlockByHash(ctx, header.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.FetchBlock {
+		blockEvent.Block = block
+	}
+
+	if opts.FetchReceipts {
+		receipts := make([]*types.Receipt, 0, len(block.Transactions()))
+		for _, tx := range block.Transactions() {
+			receipt, err := w.selectClient().TransactionReceipt(ctx, tx.Hash())
+			if err != nil {
+				return nil, err
+			}
+			receipts = append(receipts, receipt)
+		}
+		blockEvent.Receipts = receipts
+	}
+
+	return blockEvent, nil
+}
+
+// SubscribePendingTransactions listens to newPendingTransactions, resolves each
+// hash to the full transaction and forwards the ones matching filter to out.
+// Transactions that cannot be fetched, e.g. dropped ones, are skipped. It
+// returns ErrPendingTxUnsupported without a websocket endpoint, unless the
+// backend is a PendingTxBackend.
+func (w *Web3GolangHelper) SubscribePendingTransactions(ctx context.Context, filter PendingTxFilter, out chan<- *types.Transaction) (ethereum.Subscription, error) {
+
+	var (
+		source   PendingTxBackend
+		txReader ethereum.TransactionReader
+	)
+	if w.wsRpcClient != nil {
+		source, txReader = gethPendingTxs{gethclient.New(w.wsRpcClient)}, w.wsClient
+	} else if pendingBackend, ok := w.backend.(PendingTxBackend); ok {
+		source, txReader = pendingBackend, w.backend
+	} else {
+		return nil, ErrPendingTxUnsupported
+	}
+
+	hashes := make(chan common.Hash)
+	hashSub, err := source.SubscribePendingTransactions(ctx, hashes)
+	if err != nil {
+		w.recordSubscriptionError("newPendingTransactions")
+		return nil, err
+	}
+	w.recordSubscription("newPendingTransactions")
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer hashSub.Unsubscribe()
+
+		for {
+			select {
+			case err := <-hashSub.Err():
+				w.recordSubscriptionError("newPendingTransactions")
+				return err
+			case <-quit:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			case hash := <-hashes:
+				tx, _, err := txReader.TransactionByHash(ctx, hash)
+				if err != nil {
+					if !errors.Is(err, ethereum.NotFound) {
+						w.log(WarnLogLevel, "skipped pending transaction", "txHash", hash, "err", err)
+						w.countSubscriptionError("newPendingTransactions")
+					}
+					continue
+				}
+
+				if !filter.Match(tx) {
+					continue
+				}
+
+				select {
+				case out <- tx:
+				case <-quit:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+	}), nil
+}
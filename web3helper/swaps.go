@@ -0,0 +1,167 @@
+package web3helper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	pancakeFactory "github.com/nikola43/web3golanghelper/contracts/bindings/ipancakefactory"
+	pancakePair "github.com/nikola43/web3golanghelper/contracts/bindings/ipancakepair"
+	pancakeRouter "github.com/nikola43/web3golanghelper/contracts/bindings/ipancakerouter02"
+)
+
+type PairReserves struct {
+	Pair               common.Address
+	Token0             common.Address
+	Token1             common.Address
+	Reserve0           *big.Int
+	Reserve1           *big.Int
+	BlockTimestampLast uint32
+}
+
+// Network returns the network the helper was created for. Helpers built from
+// plain URLs resolve it from the built-in registry by chain ID.
+func (w *Web3GolangHelper) Network() (*EVMNetwork, error) {
+	if w.network != nil {
+		return w.network, nil
+	}
+
+	chainID, err := w.cachedChainID(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return DefaultNetworkRegistry().ByChainID(chainID.Uint64())
+}
+
+// SetNetwork sets the network of a helper created from plain URLs or a
+// backend, e.g. a local chain missing from the built-in registry.
+func (w *Web3GolangHelper) SetNetwork(network *EVMNetwork) error {
+	chainID, err := w.cachedChainID(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if !chainID.IsUint64() || chainID.Uint64() != network.ChainID {
+		return fmt.Errorf("%w: network %s expects %d, connected to %s", ErrChainIDMismatch, network.Name, network.ChainID, chainID)
+	}
+
+	w.network = network
+	return nil
+}
+
+// defaultDex returns the router, factory and wrapped native token used by Buy
+// and GetPair: the first exchange of the connected network.
+func (w *Web3GolangHelper) defaultDex() (router common.Address, factory common.Address, wrappedNative common.Address, err error) {
+	network, err := w.Network()
+	if err != nil {
+		return router, factory, wrappedNative, err
+	}
+	if len(network.Dexes) == 0 {
+		return router, factory, wrappedNative, fmt.Errorf("network %s has no dex", network.Name)
+	}
+
+	return network.Dexes[0].Router, network.Dexes[0].Factory, network.WrappedNative, nil
+}
+
+// Dex returns the exchange registered under name on the connected network.
+// An empty name selects the first exchange of the network.
+func (w *Web3GolangHelper) Dex(name string) (*DexConfig, error) {
+	network, err := w.Network()
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "" && len(network.Dexes) > 0 {
+		return &network.Dexes[0], nil
+	}
+
+	dex := network.Dex(name)
+	if dex == nil {
+		return nil, fmt.Errorf("network %s has no dex %q", network.Name, name)
+	}
+	return dex, nil
+}
+
+// ApplySlippage returns amount reduced by slippageBps basis points.
+func ApplySlippage(amount *big.Int, slippageBps int64) *big.Int {
+	result := new(big.Int).Mul(amount, big.NewInt(10000-slippageBps))
+	return result.Div(result, big.NewInt(10000))
+}
+
+// QuoteSwap returns the router amounts along path for amountIn.
+func (w *Web3GolangHelper) QuoteSwap(ctx context.Context, router common.Address, amountIn *big.Int, path []common.Address) ([]*big.Int, error) {
+	routerInstance, err := pancakeRouter.NewIPancakeRouter02Caller(router, w.selectClient())
+	if err != nil {
+		return nil, err
+	}
+
+	return routerInstance.GetAmountsOut(&bind.CallOpts{Context: ctx}, amountIn, path)
+}
+
+func (w *Web3GolangHelper) SwapExactETHForTokens(ctx context.Context, router common.Address, amountIn *big.Int, amountOutMin *big.Int, path []common.Address, to common.Address, deadline *big.Int, pk string) (*types.Transaction, error) {
+	data, err := packRouterCall("swapExactETHForTokens", amountOutMin, path, to, deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.transact(ctx, pk, router, amountIn, data)
+}
+
+func (w *Web3GolangHelper) SwapExactTokensForETH(ctx context.Context, router common.Address, amountIn *big.Int, amountOutMin *big.Int, path []common.Address, to common.Address, deadline *big.Int, pk string) (*types.Transaction, error) {
+	data, err := packRouterCall("swapExactTokensForETH", amountIn, amountOutMin, path, to, deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.transact(ctx, pk, router, nil, data)
+}
+
+func packRouterCall(method string, args ...interface{}) ([]byte, error) {
+	routerABI, err := pancakeRouter.IPancakeRouter02MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return routerABI.Pack(method, args...)
+}
+
+// GetPairAddress returns the pair of tokenA and tokenB, the zero address when
+// the factory has none.
+func (w *Web3GolangHelper) GetPairAddress(ctx context.Context, factory common.Address, tokenA common.Address, tokenB common.Address) (common.Address, error) {
+	factoryInstance, err := pancakeFactory.NewIPancakeFactoryCaller(factory, w.selectClient())
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return factoryInstance.GetPair(&bind.CallOpts{Context: ctx}, tokenA, tokenB)
+}
+
+func (w *Web3GolangHelper) GetPairReserves(ctx context.Context, pair common.Address) (*PairReserves, error) {
+	pairInstance, err := pancakePair.NewIPancakePairCaller(pair, w.selectClient())
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	reserves := &PairReserves{Pair: pair}
+
+	if reserves.Token0, err = pairInstance.Token0(opts); err != nil {
+		return nil, err
+	}
+	if reserves.Token1, err = pairInstance.Token1(opts); err != nil {
+		return nil, err
+	}
+
+	result, err := pairInstance.GetReserves(opts)
+	if err != nil {
+		return nil, err
+	}
+	reserves.Reserve0 = result.Reserve0
+	reserves.Reserve1 = result.Reserve1
+	reserves.BlockTimestampLast = result.BlockTimestampLast
+
+	return reserves, nil
+}
@@ -0,0 +1,117 @@
+package web3helper
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	erc20 "github.com/nikola43/web3golanghelper/contracts/bindings/ierc20"
+)
+
+type TokenInfo struct {
+	Address     common.Address
+	Name        string
+	Symbol      string
+	Decimals    uint8
+	TotalSupply *big.Int
+}
+
+// NewTransactor returns transact options signing with pk for the connected
+// chain. Gas and nonce are left for the binding to fill in.
+func (w *Web3GolangHelper) NewTransactor(ctx context.Context, pk string) (*bind.TransactOpts, error) {
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(pk, "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := w.cachedChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	transactor, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactor.Context = ctx
+	return transactor, nil
+}
+
+func (w *Web3GolangHelper) TokenInfo(ctx context.Context, tokenAddress string) (*TokenInfo, error) {
+	token, err := erc20.NewIERC20Caller(common.HexToAddress(tokenAddress), w.selectClient())
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	info := &TokenInfo{Address: common.HexToAddress(tokenAddress)}
+
+	if info.Name, err = token.Name(opts); err != nil {
+		return nil, err
+	}
+	if info.Symbol, err = token.Symbol(opts); err != nil {
+		return nil, err
+	}
+	if info.Decimals, err = token.Decimals(opts); err != nil {
+		return nil, err
+	}
+	if info.TotalSupply, err = token.TotalSupply(opts); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func (w *Web3GolangHelper) TokenBalance(ctx context.Context, tokenAddress string, owner string) (*big.Int, error) {
+	token, err := erc20.NewIERC20Caller(common.HexToAddress(tokenAddress), w.selectClient())
+	if err != nil {
+		return nil, err
+	}
+
+	return token.BalanceOf(&bind.CallOpts{Context: ctx}, common.HexToAddress(owner))
+}
+
+func (w *Web3GolangHelper) TokenAllowance(ctx context.Context, tokenAddress string, owner string, spender string) (*big.Int, error) {
+	token, err := erc20.NewIERC20Caller(common.HexToAddress(tokenAddress), w.selectClient())
+	if err != nil {
+		return nil, err
+	}
+
+	return token.Allowance(&bind.CallOpts{Context: ctx}, common.HexToAddress(owner), common.HexToAddress(spender))
+}
+
+// TransferToken sends amount token units (not decimals adjusted) to toAddress.
+func (w *Web3GolangHelper) TransferToken(ctx context.Context, tokenAddress string, toAddress string, amount *big.Int, pk string) (*types.Transaction, error) {
+	tokenABI, err := erc20.IERC20MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := tokenABI.Pack("transfer", common.HexToAddress(toAddress), amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.transact(ctx, pk, common.HexToAddress(tokenAddress), nil, data)
+}
+
+// ApproveToken allows spender to move amount token units of the pk account.
+func (w *Web3GolangHelper) ApproveToken(ctx context.Context, tokenAddress string, spender string, amount *big.Int, pk string) (*types.Transaction, error) {
+	tokenABI, err := erc20.IERC20MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := tokenABI.Pack("approve", common.HexToAddress(spender), amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.transact(ctx, pk, common.HexToAddress(tokenAddress), nil, data)
+}
@@ -0,0 +1,165 @@
+package web3helper
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// BuildTransaction returns the unsigned transaction of msg at the pending
+// nonce of msg.From. Unset gas and fees come from the gas limit strategy and
+// the fee oracle. msg.AccessList is attached as is; without one, the list of
+// CreateAccessList is attached when SetAutoAccessList is on and it saves gas.
+// Chains with EIP-1559 get dynamic fee transactions unless msg.GasPrice is
+// set, others access list transactions when a list is attached.
+func (w *Web3GolangHelper) BuildTransaction(ctx context.Context, msg ethereum.CallMsg) (*types.Transaction, error) {
+	chainID, err := w.cachedChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := w.selectClient().PendingNonceAt(ctx, msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	fees, err := w.SuggestFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.AccessList == nil && msg.Gas == 0 && w.autoAccessList && len(msg.Data) > 0 {
+		if err := w.attachAccessList(ctx, &msg); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.Gas == 0 {
+		if msg.Gas, err = w.GasLimit(ctx, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	if fees.BaseFee != nil && msg.GasPrice == nil {
+		gasTipCap := fees.GasTipCap
+		if msg.GasTipCap != nil {
+			gasTipCap = msg.GasTipCap
+		}
+		gasFeeCap := fees.GasFeeCap
+		if msg.GasFeeCap != nil {
+			gasFeeCap = msg.GasFeeCap
+		}
+
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      nonce,
+			GasTipCap:  gasTipCap,
+			GasFeeCap:  gasFeeCap,
+			Gas:        msg.Gas,
+			To:         msg.To,
+			Value:      msg.Value,
+			Data:       msg.Data,
+			AccessList: msg.AccessList,
+		}), nil
+	}
+
+	gasPrice := fees.GasPrice
+	if msg.GasPrice != nil {
+		gasPrice = msg.GasPrice
+	}
+
+	if msg.AccessList != nil {
+		return types.NewTx(&types.AccessListTx{
+			ChainID:    chainID,
+			Nonce:      nonce,
+			GasPrice:   gasPrice,
+			Gas:        msg.Gas,
+			To:         msg.To,
+			Value:      msg.Value,
+			Data:       msg.Data,
+			AccessList: msg.AccessList,
+		}), nil
+	}
+
+	return types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      msg.Gas,
+		To:       msg.To,
+		Value:    msg.Value,
+		Data:     msg.Data,
+	}), nil
+}
+
+// attachAccessList sets the access list and gas limit of msg when the list
+// saves gas. Nodes that cannot create lists leave msg unchanged.
+func (w *Web3GolangHelper) attachAccessList(ctx context.Context, msg *ethereum.CallMsg) error {
+	result, err := w.CreateAccessList(ctx, *msg)
+	if err != nil {
+		// the gas estimate reports reverts with their reason
+		w.log(DebugLogLevel, "access list skipped", "err", err)
+		return nil
+	}
+
+	w.log(DebugLogLevel, "access list created",
+		"entries", len(result.AccessList),
+		"gasWithList", result.GasWithList,
+		"gasWithoutList", result.GasWithoutList)
+
+	if !result.Saves() {
+		return nil
+	}
+
+	gas, err := w.gasLimitStrategy().limit(result.GasWithList, msg.Data)
+	if err != nil {
+		return err
+	}
+
+	msg.AccessList = result.AccessList
+	msg.Gas = gas
+	return nil
+}
+
+// transact builds a transaction calling to with data from the pk account,
+// signs and sends it.
+func (w *Web3GolangHelper) transact(ctx context.Context, pk string, to common.Address, value *big.Int, data []byte) (*types.Transaction, error) {
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(pk, "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := w.cachedChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := w.BuildTransaction(ctx, ethereum.CallMsg{
+		From:  crypto.PubkeyToAddress(privateKey.PublicKey),
+		To:    &to,
+		Value: value,
+		Data:  data,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(chainID), privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.sendTransaction(ctx, signedTx); err != nil {
+		if revert := w.DecodeRevert(err); revert != nil {
+			return nil, revert
+		}
+		return nil, err
+	}
+
+	w.notifyTxSent(signedTx)
+	return signedTx, nil
+}
@@ -0,0 +1,116 @@
+package web3helper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var ErrWalletNotFound = errors.New("wallet not found")
+
+// WalletStore keeps one Account JSON file per address in a directory, the
+// layout GenerateWallet writes to.
+type WalletStore struct {
+	dir string
+}
+
+func NewWalletStore(dir string) *WalletStore {
+	return &WalletStore{dir: dir}
+}
+
+// Create generates a new key pair and stores it.
+func (s *WalletStore) Create() (*Account, error) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Import(hexutil.Encode(crypto.FromECDSA(privateKey))[2:])
+}
+
+// Import stores the account of a hex private key, with or without 0x prefix.
+func (s *WalletStore) Import(pk string) (*Account, error) {
+	pk = strings.TrimPrefix(pk, "0x")
+
+	address, _, err := GenerateAddressFromPlainPrivateKey(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	account := &Account{
+		PublicKey:  address.Hex(),
+		PrivateKey: pk,
+	}
+
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return nil, err
+	}
+
+	file, err := json.MarshalIndent(account, "", " ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(s.dir, account.PublicKey+".json"), file, 0600); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// List returns every account of the store. Files that are not accounts are skipped.
+func (s *WalletStore) List() ([]*Account, error) {
+	accounts := make([]*Account, 0)
+
+	files, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return accounts, nil
+		}
+		return nil, err
+	}
+
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != ".json" {
+			continue
+		}
+
+		content, err := ioutil.ReadFile(filepath.Join(s.dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		account := new(Account)
+		if err := json.Unmarshal(content, account); err != nil || !common.IsHexAddress(account.PublicKey) {
+			continue
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
+// Get returns the account of address.
+func (s *WalletStore) Get(address string) (*Account, error) {
+	accounts, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, account := range accounts {
+		if strings.EqualFold(account.PublicKey, address) {
+			return account, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrWalletNotFound, address)
+}
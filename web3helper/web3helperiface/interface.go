@@ -1,15 +1,137 @@
-package web3helper
+// Package web3helperiface provides an interface of web3helper.Web3GolangHelper
+// so services can depend on it and use MockWeb3Helper in unit tests.
+package web3helperiface
 
-/*
-package web3helper
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
 
-import "math/big"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 
-type Web3ManagerInterface interface {
-	AddHttpClient(httpClient *ethclient.Client) *Web3Manager
-	SuggestGasPrice() *big.Int
-	AddWsClient(wsClient *ethclient.Client) *Web3Manager
+	"github.com/nikola43/web3golanghelper/web3helper"
+)
+
+//go:generate go run ./internal/mockgen -source interface.go -interface Web3Helper -mock MockWeb3Helper -out mock.go
+
+// ChainReader reads the connected chain.
+type ChainReader interface {
+	ChainId() *big.Int
+	CurrentBlockNumber() uint64
+	VerifyChainID(ctx context.Context, expected uint64) error
+	Network() (*web3helper.EVMNetwork, error)
+	Explorer() (*web3helper.Explorer, error)
+	Dex(name string) (*web3helper.DexConfig, error)
+}
+
+// BalanceReader reads native balances and account code.
+type BalanceReader interface {
 	GetEthBalance(address string) *big.Int
+	Balance(account common.Address) *big.Int
 	IsAddressContract(address string) bool
 }
-*/
+
+// GasEstimator prices and estimates transactions.
+type GasEstimator interface {
+	SuggestGasPrice() *big.Int
+	SuggestFees(ctx context.Context) (*web3helper.FeeEstimate, error)
+	EstimateGas(to string, txData []byte) uint64
+	EstimateTxResult(to string, txData []byte) bool
+	PendingNonce(fromAddress common.Address) *big.Int
+	GasLimit(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+	QuoteGas(ctx context.Context, msg ethereum.CallMsg) (*web3helper.GasQuote, error)
+	CreateAccessList(ctx context.Context, msg ethereum.CallMsg) (*web3helper.AccessListResult, error)
+}
+
+// TxSender signs, sends and replaces transactions.
+type TxSender interface {
+	NewTransactor(ctx context.Context, pk string) (*bind.TransactOpts, error)
+	BuildTransaction(ctx context.Context, msg ethereum.CallMsg) (*types.Transaction, error)
+	BuildUnsignedTransaction(ctx context.Context, msg ethereum.CallMsg) (*web3helper.UnsignedTransaction, error)
+	BroadcastSignedTransaction(ctx context.Context, u *web3helper.UnsignedTransaction, raw string) (*types.Transaction, error)
+	BroadcastTransaction(ctx context.Context, tx *types.Transaction) (*web3helper.BroadcastResult, error)
+	SendBundle(ctx context.Context, txs []*types.Transaction, blocks uint64) (*web3helper.BundleSubmission, error)
+	WaitForBundle(ctx context.Context, submission *web3helper.BundleSubmission) ([]*types.Receipt, error)
+	WaitForInclusion(ctx context.Context, txHashes []common.Hash, lastBlock uint64) ([]*types.Receipt, error)
+	WaitForPrivateTransaction(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	SignTx(tx *types.Transaction, pk string) (*types.Transaction, error)
+	SendEth(fromAddress common.Address, toAddressString string, value string, pk string) (string, *big.Int, error)
+	SignAndSend(toAddressString string, value *big.Int, data []byte, nonce *big.Int, customGasPrice interface{}, customGasLimit interface{}, pk string) (*web3helper.TxResult, error)
+	SignAndSendTransaction(toAddressString string, value *big.Int, data []byte, nonce *big.Int, customGasPrice interface{}, customGasLimit interface{}, pk string) (string, *big.Int, error)
+	CancelTx(to string, nonce *big.Int, multiplier int64, pk string) (string, error)
+	TransactionStatus(ctx context.Context, txHash string) (*web3helper.TxStatus, error)
+	SpeedUpTransaction(ctx context.Context, txHash string, bumpPercent int64, pk string) (*types.Transaction, error)
+	CancelPendingTransaction(ctx context.Context, txHash string, bumpPercent int64, pk string) (*types.Transaction, error)
+	CancelTransaction(ctx context.Context, transaction *types.Transaction, privateKey *ecdsa.PrivateKey) (*types.Transaction, error)
+	OnTransactionSent(hook web3helper.TxSentHook)
+}
+
+// TokenClient reads and moves ERC20 tokens.
+type TokenClient interface {
+	TokenInfo(ctx context.Context, tokenAddress string) (*web3helper.TokenInfo, error)
+	TokenBalance(ctx context.Context, tokenAddress string, owner string) (*big.Int, error)
+	TokenAllowance(ctx context.Context, tokenAddress string, owner string, spender string) (*big.Int, error)
+	TransferToken(ctx context.Context, tokenAddress string, toAddress string, amount *big.Int, pk string) (*types.Transaction, error)
+	ApproveToken(ctx context.Context, tokenAddress string, spender string, amount *big.Int, pk string) (*types.Transaction, error)
+	SendTokens(tokenAddressString, toAddressString string, value *big.Int, pk string) (string, *big.Int, error)
+}
+
+// SwapClient quotes and executes swaps on UniswapV2 style routers.
+type SwapClient interface {
+	QuoteSwap(ctx context.Context, router common.Address, amountIn *big.Int, path []common.Address) ([]*big.Int, error)
+	SwapExactETHForTokens(ctx context.Context, router common.Address, amountIn *big.Int, amountOutMin *big.Int, path []common.Address, to common.Address, deadline *big.Int, pk string) (*types.Transaction, error)
+	SwapExactTokensForETH(ctx context.Context, router common.Address, amountIn *big.Int, amountOutMin *big.Int, path []common.Address, to common.Address, deadline *big.Int, pk string) (*types.Transaction, error)
+	GetPairAddress(ctx context.Context, factory common.Address, tokenA common.Address, tokenB common.Address) (common.Address, error)
+	GetPairReserves(ctx context.Context, pair common.Address) (*web3helper.PairReserves, error)
+	Buy(fromAddress common.Address, tokenAddress string, bnbAmount float64, pk string) (string, error)
+	GetReserves(pairAddress string) web3helper.Reserve
+	GetPair(tokenAddress string) (string, error)
+}
+
+// RevertDecoder simulates calls and explains reverts.
+type RevertDecoder interface {
+	Simulate(ctx context.Context, msg ethereum.CallMsg) ([]byte, error)
+	DecodeRevert(err error) *web3helper.RevertError
+	DecodeRevertData(data []byte) *web3helper.RevertError
+	RegisterErrorABI(abiJSON string) error
+	TransactionRevert(ctx context.Context, txHash string) (*web3helper.RevertError, error)
+}
+
+// ContractBinder calls contracts by method name through their ABI.
+type ContractBinder interface {
+	BindContract(address common.Address, abiJSON string) (*web3helper.Contract, error)
+	BindContractFile(address common.Address, path string) (*web3helper.Contract, error)
+}
+
+// Signer builds what off-chain signatures commit to.
+type Signer interface {
+	TypedDataDomain(ctx context.Context, name string, version string, verifyingContract common.Address) (web3helper.TypedDataDomain, error)
+}
+
+// Subscriber streams blocks, pending transactions and contract logs.
+type Subscriber interface {
+	SubscribeNewBlocks(ctx context.Context, opts web3helper.BlockSubscriptionOptions, out chan<- *web3helper.BlockEvent) (ethereum.Subscription, error)
+	SubscribePendingTransactions(ctx context.Context, filter web3helper.PendingTxFilter, out chan<- *types.Transaction) (ethereum.Subscription, error)
+	ListenBridgesEventsV2(contractsAddresses []string, out chan<- types.Log) (ethereum.Subscription, error)
+	GenerateContractEventSubscription(contractAddress string) (chan types.Log, ethereum.Subscription, error)
+}
+
+// Web3Helper is the public surface of web3helper.Web3GolangHelper, without
+// the client accessors and configuration setters.
+type Web3Helper interface {
+	ChainReader
+	BalanceReader
+	GasEstimator
+	TxSender
+	TokenClient
+	SwapClient
+	ContractBinder
+	RevertDecoder
+	Signer
+	Subscriber
+}
+
+var _ Web3Helper = (*web3helper.Web3GolangHelper)(nil)
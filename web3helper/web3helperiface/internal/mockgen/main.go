@@ -0,0 +1,288 @@
+// Command mockgen writes an in-memory mock of an interface declared in a Go
+// source file. Embedded interfaces must be declared in the same file.
+//
+//	go run ./internal/mockgen -source interface.go -interface Web3Helper -mock MockWeb3Helper -out mock.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+type param struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+func main() {
+	source := flag.String("source", "", "source file declaring the interface")
+	interfaceName := flag.String("interface", "", "interface to mock")
+	mockName := flag.String("mock", "", "name of the mock type, Mock<interface> by default")
+	out := flag.String("out", "", "output file, stdout by default")
+	flag.Parse()
+
+	if *source == "" || *interfaceName == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *mockName == "" {
+		*mockName = "Mock" + *interfaceName
+	}
+
+	code, err := generate(*source, *interfaceName, *mockName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *out == "" {
+		os.Stdout.Write(code)
+		return
+	}
+	if err := os.WriteFile(*out, code, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(source, interfaceName, mockName string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, source, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces := make(map[string]*ast.InterfaceType)
+	ast.Inspect(file, func(node ast.Node) bool {
+		if spec, ok := node.(*ast.TypeSpec); ok {
+			if iface, ok := spec.Type.(*ast.InterfaceType); ok {
+				interfaces[spec.Name.Name] = iface
+			}
+		}
+		return true
+	})
+
+	methods, err := collectMethods(fset, interfaces, interfaceName, make(map[string]bool))
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by mockgen from %s. DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(buf, "package %s\n\n", file.Name.Name)
+
+	writeImports(buf, file.Imports)
+
+	callName := mockName + "Call"
+	fmt.Fprintf(buf, "// %s is an in-memory %s. Every method records its call and\n", mockName, interfaceName)
+	buf.WriteString("// runs the matching Func field, returning zero values when it is nil.\n")
+	fmt.Fprintf(buf, "type %s struct {\n", mockName)
+	for _, m := range methods {
+		fmt.Fprintf(buf, "\t%sFunc func(%s) %s\n", m.name, paramTypes(m.params), resultTypes(m.results))
+	}
+	fmt.Fprintf(buf, "\n\tmu    sync.Mutex\n\tcalls []%s\n}\n\n", callName)
+
+	fmt.Fprintf(buf, "// %s is a recorded method call.\n", callName)
+	fmt.Fprintf(buf, "type %s struct {\n\tMethod string\n\tArgs   []interface{}\n}\n\n", callName)
+
+	fmt.Fprintf(buf, "var _ %s = (*%s)(nil)\n\n", interfaceName, mockName)
+
+	fmt.Fprintf(buf, "// Calls returns the calls made so far, oldest first.\n")
+	fmt.Fprintf(buf, "func (mock *%s) Calls() []%s {\n", mockName, callName)
+	fmt.Fprintf(buf, "\tmock.mu.Lock()\n\tdefer mock.mu.Unlock()\n\n\treturn append([]%s(nil), mock.calls...)\n}\n\n", callName)
+
+	fmt.Fprintf(buf, "// CallCount returns how many times method was called.\n")
+	fmt.Fprintf(buf, "func (mock *%s) CallCount(method string) int {\n", mockName)
+	buf.WriteString("\tmock.mu.Lock()\n\tdefer mock.mu.Unlock()\n\n\tcount := 0\n\tfor _, call := range mock.calls {\n\t\tif call.Method == method {\n\t\t\tcount++\n\t\t}\n\t}\n\treturn count\n}\n\n")
+
+	fmt.Fprintf(buf, "func (mock *%s) record(method string, args ...interface{}) {\n", mockName)
+	fmt.Fprintf(buf, "\tmock.mu.Lock()\n\tdefer mock.mu.Unlock()\n\n\tmock.calls = append(mock.calls, %s{Method: method, Args: args})\n}\n", callName)
+
+	for _, m := range methods {
+		writeMethod(buf, mockName, m)
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// writeImports writes the imports of the source file plus sync, standard
+// library first.
+func writeImports(buf *bytes.Buffer, imports []*ast.ImportSpec) {
+	std := []string{`"sync"`}
+	other := make([]string, 0, len(imports))
+
+	for _, spec := range imports {
+		line := spec.Path.Value
+		if spec.Name != nil {
+			line = spec.Name.Name + " " + line
+		}
+
+		path := strings.Trim(spec.Path.Value, `"`)
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			other = append(other, line)
+		} else {
+			std = append(std, line)
+		}
+	}
+	sort.Strings(std)
+
+	buf.WriteString("import (\n")
+	for _, line := range std {
+		fmt.Fprintf(buf, "\t%s\n", line)
+	}
+	if len(other) > 0 {
+		buf.WriteString("\n")
+	}
+	for _, line := range other {
+		fmt.Fprintf(buf, "\t%s\n", line)
+	}
+	buf.WriteString(")\n\n")
+}
+
+// collectMethods returns the methods of name, embedded interfaces included,
+// in declaration order.
+func collectMethods(fset *token.FileSet, interfaces map[string]*ast.InterfaceType, name string, seen map[string]bool) ([]method, error) {
+	iface, ok := interfaces[name]
+	if !ok {
+		return nil, fmt.Errorf("interface %s not found", name)
+	}
+
+	methods := make([]method, 0)
+	for _, field := range iface.Methods.List {
+		switch typ := field.Type.(type) {
+		case *ast.Ident:
+			embedded, err := collectMethods(fset, interfaces, typ.Name, seen)
+			if err != nil {
+				return nil, err
+			}
+			methods = append(methods, embedded...)
+		case *ast.FuncType:
+			name := field.Names[0].Name
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			methods = append(methods, newMethod(fset, name, typ))
+		default:
+			return nil, fmt.Errorf("unsupported interface element %s", render(fset, field.Type))
+		}
+	}
+	return methods, nil
+}
+
+func newMethod(fset *token.FileSet, name string, fn *ast.FuncType) method {
+	m := method{name: name}
+
+	for _, field := range fn.Params.List {
+		typ := field.Type
+		variadic := false
+		if ellipsis, ok := typ.(*ast.Ellipsis); ok {
+			typ = ellipsis.Elt
+			variadic = true
+		}
+
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{{Name: ""}}
+		}
+		for _, ident := range names {
+			paramName := ident.Name
+			if paramName == "" || paramName == "_" || paramName == "mock" {
+				paramName = fmt.Sprintf("p%d", len(m.params))
+			}
+			m.params = append(m.params, param{name: paramName, typ: render(fset, typ), variadic: variadic})
+		}
+	}
+
+	if fn.Results != nil {
+		for _, field := range fn.Results.List {
+			count := len(field.Names)
+			if count == 0 {
+				count = 1
+			}
+			for i := 0; i < count; i++ {
+				m.results = append(m.results, render(fset, field.Type))
+			}
+		}
+	}
+	return m
+}
+
+func writeMethod(buf *bytes.Buffer, mockName string, m method) {
+	params := make([]string, 0, len(m.params))
+	args := make([]string, 0, len(m.params))
+	callArgs := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		if p.variadic {
+			params = append(params, p.name+" ..."+p.typ)
+			callArgs = append(callArgs, p.name+"...")
+		} else {
+			params = append(params, p.name+" "+p.typ)
+			callArgs = append(callArgs, p.name)
+		}
+		args = append(args, p.name)
+	}
+
+	results := ""
+	if len(m.results) > 0 {
+		named := make([]string, 0, len(m.results))
+		for i, result := range m.results {
+			named = append(named, fmt.Sprintf("r%d %s", i, result))
+		}
+		results = "(" + strings.Join(named, ", ") + ")"
+	}
+
+	recordArgs := ""
+	if len(args) > 0 {
+		recordArgs = ", " + strings.Join(args, ", ")
+	}
+
+	fmt.Fprintf(buf, "\nfunc (mock *%s) %s(%s) %s {\n", mockName, m.name, strings.Join(params, ", "), results)
+	fmt.Fprintf(buf, "\tmock.record(%q%s)\n", m.name, recordArgs)
+	fmt.Fprintf(buf, "\tif mock.%sFunc != nil {\n", m.name)
+	if len(m.results) > 0 {
+		fmt.Fprintf(buf, "\t\treturn mock.%sFunc(%s)\n\t}\n\treturn\n}\n", m.name, strings.Join(callArgs, ", "))
+	} else {
+		fmt.Fprintf(buf, "\t\tmock.%sFunc(%s)\n\t}\n}\n", m.name, strings.Join(callArgs, ", "))
+	}
+}
+
+func paramTypes(params []param) string {
+	types := make([]string, 0, len(params))
+	for _, p := range params {
+		if p.variadic {
+			types = append(types, "..."+p.typ)
+		} else {
+			types = append(types, p.typ)
+		}
+	}
+	return strings.Join(types, ", ")
+}
+
+func resultTypes(results []string) string {
+	if len(results) <= 1 {
+		return strings.Join(results, "")
+	}
+	return "(" + strings.Join(results, ", ") + ")"
+}
+
+func render(fset *token.FileSet, expr ast.Expr) string {
+	buf := &bytes.Buffer{}
+	printer.Fprint(buf, fset, expr)
+	return buf.String()
+}
@@ -0,0 +1,618 @@
+// Code generated by mockgen from interface.go. DO NOT EDIT.
+
+package web3helperiface
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/nikola43/web3golanghelper/web3helper"
+)
+
+// MockWeb3Helper is an in-memory Web3Helper. Every method records its call and
+// runs the matching Func field, returning zero values when it is nil.
+type MockWeb3Helper struct {
+	ChainIdFunc                           func() *big.Int
+	CurrentBlockNumberFunc                func() uint64
+	VerifyChainIDFunc                     func(context.Context, uint64) error
+	NetworkFunc                           func() (*web3helper.EVMNetwork, error)
+	ExplorerFunc                          func() (*web3helper.Explorer, error)
+	DexFunc                               func(string) (*web3helper.DexConfig, error)
+	GetEthBalanceFunc                     func(string) *big.Int
+	BalanceFunc                           func(common.Address) *big.Int
+	IsAddressContractFunc                 func(string) bool
+	SuggestGasPriceFunc                   func() *big.Int
+	SuggestFeesFunc                       func(context.Context) (*web3helper.FeeEstimate, error)
+	EstimateGasFunc                       func(string, []byte) uint64
+	EstimateTxResultFunc                  func(string, []byte) bool
+	PendingNonceFunc                      func(common.Address) *big.Int
+	GasLimitFunc                          func(context.Context, ethereum.CallMsg) (uint64, error)
+	QuoteGasFunc                          func(context.Context, ethereum.CallMsg) (*web3helper.GasQuote, error)
+	CreateAccessListFunc                  func(context.Context, ethereum.CallMsg) (*web3helper.AccessListResult, error)
+	NewTransactorFunc                     func(context.Context, string) (*bind.TransactOpts, error)
+	BuildTransactionFunc                  func(context.Context, ethereum.CallMsg) (*types.Transaction, error)
+	BuildUnsignedTransactionFunc          func(context.Context, ethereum.CallMsg) (*web3helper.UnsignedTransaction, error)
+	BroadcastSignedTransactionFunc        func(context.Context, *web3helper.UnsignedTransaction, string) (*types.Transaction, error)
+	BroadcastTransactionFunc              func(context.Context, *types.Transaction) (*web3helper.BroadcastResult, error)
+	SendBundleFunc                        func(context.Context, []*types.Transaction, uint64) (*web3helper.BundleSubmission, error)
+	WaitForBundleFunc                     func(context.Context, *web3helper.BundleSubmission) ([]*types.Receipt, error)
+	WaitForInclusionFunc                  func(context.Context, []common.Hash, uint64) ([]*types.Receipt, error)
+	WaitForPrivateTransactionFunc         func(context.Context, common.Hash) (*types.Receipt, error)
+	SignTxFunc                            func(*types.Transaction, string) (*types.Transaction, error)
+	SendEthFunc                           func(common.Address, string, string, string) (string, *big.Int, error)
+	SignAndSendFunc                       func(string, *big.Int, []byte, *big.Int, interface{}, interface{}, string) (*web3helper.TxResult, error)
+	SignAndSendTransactionFunc            func(string, *big.Int, []byte, *big.Int, interface{}, interface{}, string) (string, *big.Int, error)
+	CancelTxFunc                          func(string, *big.Int, int64, string) (string, error)
+	TransactionStatusFunc                 func(context.Context, string) (*web3helper.TxStatus, error)
+	SpeedUpTransactionFunc                func(context.Context, string, int64, string) (*types.Transaction, error)
+	CancelPendingTransactionFunc          func(context.Context, string, int64, string) (*types.Transaction, error)
+	CancelTransactionFunc                 func(context.Context, *types.Transaction, *ecdsa.PrivateKey) (*types.Transaction, error)
+	OnTransactionSentFunc                 func(web3helper.TxSentHook)
+	TokenInfoFunc                         func(context.Context, string) (*web3helper.TokenInfo, error)
+	TokenBalanceFunc                      func(context.Context, string, string) (*big.Int, error)
+	TokenAllowanceFunc                    func(context.Context, string, string, string) (*big.Int, error)
+	TransferTokenFunc                     func(context.Context, string, string, *big.Int, string) (*types.Transaction, error)
+	ApproveTokenFunc                      func(context.Context, string, string, *big.Int, string) (*types.Transaction, error)
+	SendTokensFunc                        func(string, string, *big.Int, string) (string, *big.Int, error)
+	QuoteSwapFunc                         func(context.Context, common.Address, *big.Int, []common.Address) ([]*big.Int, error)
+	SwapExactETHForTokensFunc             func(context.Context, common.Address, *big.Int, *big.Int, []common.Address, common.Address, *big.Int, string) (*types.Transaction, error)
+	SwapExactTokensForETHFunc             func(context.Context, common.Address, *big.Int, *big.Int, []common.Address, common.Address, *big.Int, string) (*types.Transaction, error)
+	GetPairAddressFunc                    func(context.Context, common.Address, common.Address, common.Address) (common.Address, error)
+	GetPairReservesFunc                   func(context.Context, common.Address) (*web3helper.PairReserves, error)
+	BuyFunc                               func(common.Address, string, float64, string) (string, error)
+	GetReservesFunc                       func(string) web3helper.Reserve
+	GetPairFunc                           func(string) (string, error)
+	BindContractFunc                      func(common.Address, string) (*web3helper.Contract, error)
+	BindContractFileFunc                  func(common.Address, string) (*web3helper.Contract, error)
+	SimulateFunc                          func(context.Context, ethereum.CallMsg) ([]byte, error)
+	DecodeRevertFunc                      func(error) *web3helper.RevertError
+	DecodeRevertDataFunc                  func([]byte) *web3helper.RevertError
+	RegisterErrorABIFunc                  func(string) error
+	TransactionRevertFunc                 func(context.Context, string) (*web3helper.RevertError, error)
+	TypedDataDomainFunc                   func(context.Context, string, string, common.Address) (web3helper.TypedDataDomain, error)
+	SubscribeNewBlocksFunc                func(context.Context, web3helper.BlockSubscriptionOptions, chan<- *web3helper.BlockEvent) (ethereum.Subscription, error)
+	SubscribePendingTransactionsFunc      func(context.Context, web3helper.PendingTxFilter, chan<- *types.Transaction) (ethereum.Subscription, error)
+	ListenBridgesEventsV2Func             func([]string, chan<- types.Log) (ethereum.Subscription, error)
+	GenerateContractEventSubscriptionFunc func(string) (chan types.Log, ethereum.Subscription, error)
+
+	mu    sync.Mutex
+	calls []MockWeb3HelperCall
+}
+
+// MockWeb3HelperCall is a recorded method call.
+type MockWeb3HelperCall struct {
+	Method string
+	Args   []interface{}
+}
+
+var _ Web3Helper = (*MockWeb3Helper)(nil)
+
+// Calls returns the calls made so far, oldest first.
+func (mock *MockWeb3Helper) Calls() []MockWeb3HelperCall {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return append([]MockWeb3HelperCall(nil), mock.calls...)
+}
+
+// CallCount returns how many times method was called.
+func (mock *MockWeb3Helper) CallCount(method string) int {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	count := 0
+	for _, call := range mock.calls {
+		if call.Method == method {
+			count++
+		}
+	}
+	return count
+}
+
+func (mock *MockWeb3Helper) record(method string, args ...interface{}) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	mock.calls = append(mock.calls, MockWeb3HelperCall{Method: method, Args: args})
+}
+
+func (mock *MockWeb3Helper) ChainId() (r0 *big.Int) {
+	mock.record("ChainId")
+	if mock.ChainIdFunc != nil {
+		return mock.ChainIdFunc()
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) CurrentBlockNumber() (r0 uint64) {
+	mock.record("CurrentBlockNumber")
+	if mock.CurrentBlockNumberFunc != nil {
+		return mock.CurrentBlockNumberFunc()
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) VerifyChainID(ctx context.Context, expected uint64) (r0 error) {
+	mock.record("VerifyChainID", ctx, expected)
+	if mock.VerifyChainIDFunc != nil {
+		return mock.VerifyChainIDFunc(ctx, expected)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) Network() (r0 *web3helper.EVMNetwork, r1 error) {
+	mock.record("Network")
+	if mock.NetworkFunc != nil {
+		return mock.NetworkFunc()
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) Explorer() (r0 *web3helper.Explorer, r1 error) {
+	mock.record("Explorer")
+	if mock.ExplorerFunc != nil {
+		return mock.ExplorerFunc()
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) Dex(name string) (r0 *web3helper.DexConfig, r1 error) {
+	mock.record("Dex", name)
+	if mock.DexFunc != nil {
+		return mock.DexFunc(name)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) GetEthBalance(address string) (r0 *big.Int) {
+	mock.record("GetEthBalance", address)
+	if mock.GetEthBalanceFunc != nil {
+		return mock.GetEthBalanceFunc(address)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) Balance(account common.Address) (r0 *big.Int) {
+	mock.record("Balance", account)
+	if mock.BalanceFunc != nil {
+		return mock.BalanceFunc(account)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) IsAddressContract(address string) (r0 bool) {
+	mock.record("IsAddressContract", address)
+	if mock.IsAddressContractFunc != nil {
+		return mock.IsAddressContractFunc(address)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) SuggestGasPrice() (r0 *big.Int) {
+	mock.record("SuggestGasPrice")
+	if mock.SuggestGasPriceFunc != nil {
+		return mock.SuggestGasPriceFunc()
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) SuggestFees(ctx context.Context) (r0 *web3helper.FeeEstimate, r1 error) {
+	mock.record("SuggestFees", ctx)
+	if mock.SuggestFeesFunc != nil {
+		return mock.SuggestFeesFunc(ctx)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) EstimateGas(to string, txData []byte) (r0 uint64) {
+	mock.record("EstimateGas", to, txData)
+	if mock.EstimateGasFunc != nil {
+		return mock.EstimateGasFunc(to, txData)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) EstimateTxResult(to string, txData []byte) (r0 bool) {
+	mock.record("EstimateTxResult", to, txData)
+	if mock.EstimateTxResultFunc != nil {
+		return mock.EstimateTxResultFunc(to, txData)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) PendingNonce(fromAddress common.Address) (r0 *big.Int) {
+	mock.record("PendingNonce", fromAddress)
+	if mock.PendingNonceFunc != nil {
+		return mock.PendingNonceFunc(fromAddress)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) GasLimit(ctx context.Context, msg ethereum.CallMsg) (r0 uint64, r1 error) {
+	mock.record("GasLimit", ctx, msg)
+	if mock.GasLimitFunc != nil {
+		return mock.GasLimitFunc(ctx, msg)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) QuoteGas(ctx context.Context, msg ethereum.CallMsg) (r0 *web3helper.GasQuote, r1 error) {
+	mock.record("QuoteGas", ctx, msg)
+	if mock.QuoteGasFunc != nil {
+		return mock.QuoteGasFunc(ctx, msg)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) CreateAccessList(ctx context.Context, msg ethereum.CallMsg) (r0 *web3helper.AccessListResult, r1 error) {
+	mock.record("CreateAccessList", ctx, msg)
+	if mock.CreateAccessListFunc != nil {
+		return mock.CreateAccessListFunc(ctx, msg)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) NewTransactor(ctx context.Context, pk string) (r0 *bind.TransactOpts, r1 error) {
+	mock.record("NewTransactor", ctx, pk)
+	if mock.NewTransactorFunc != nil {
+		return mock.NewTransactorFunc(ctx, pk)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) BuildTransaction(ctx context.Context, msg ethereum.CallMsg) (r0 *types.Transaction, r1 error) {
+	mock.record("BuildTransaction", ctx, msg)
+	if mock.BuildTransactionFunc != nil {
+		return mock.BuildTransactionFunc(ctx, msg)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) BuildUnsignedTransaction(ctx context.Context, msg ethereum.CallMsg) (r0 *web3helper.UnsignedTransaction, r1 error) {
+	mock.record("BuildUnsignedTransaction", ctx, msg)
+	if mock.BuildUnsignedTransactionFunc != nil {
+		return mock.BuildUnsignedTransactionFunc(ctx, msg)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) BroadcastSignedTransaction(ctx context.Context, u *web3helper.UnsignedTransaction, raw string) (r0 *types.Transaction, r1 error) {
+	mock.record("BroadcastSignedTransaction", ctx, u, raw)
+	if mock.BroadcastSignedTransactionFunc != nil {
+		return mock.BroadcastSignedTransactionFunc(ctx, u, raw)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) BroadcastTransaction(ctx context.Context, tx *types.Transaction) (r0 *web3helper.BroadcastResult, r1 error) {
+	mock.record("BroadcastTransaction", ctx, tx)
+	if mock.BroadcastTransactionFunc != nil {
+		return mock.BroadcastTransactionFunc(ctx, tx)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) SendBundle(ctx context.Context, txs []*types.Transaction, blocks uint64) (r0 *web3helper.BundleSubmission, r1 error) {
+	mock.record("SendBundle", ctx, txs, blocks)
+	if mock.SendBundleFunc != nil {
+		return mock.SendBundleFunc(ctx, txs, blocks)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) WaitForBundle(ctx context.Context, submission *web3helper.BundleSubmission) (r0 []*types.Receipt, r1 error) {
+	mock.record("WaitForBundle", ctx, submission)
+	if mock.WaitForBundleFunc != nil {
+		return mock.WaitForBundleFunc(ctx, submission)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) WaitForInclusion(ctx context.Context, txHashes []common.Hash, lastBlock uint64) (r0 []*types.Receipt, r1 error) {
+	mock.record("WaitForInclusion", ctx, txHashes, lastBlock)
+	if mock.WaitForInclusionFunc != nil {
+		return mock.WaitForInclusionFunc(ctx, txHashes, lastBlock)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) WaitForPrivateTransaction(ctx context.Context, txHash common.Hash) (r0 *types.Receipt, r1 error) {
+	mock.record("WaitForPrivateTransaction", ctx, txHash)
+	if mock.WaitForPrivateTransactionFunc != nil {
+		return mock.WaitForPrivateTransactionFunc(ctx, txHash)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) SignTx(tx *types.Transaction, pk string) (r0 *types.Transaction, r1 error) {
+	mock.record("SignTx", tx, pk)
+	if mock.SignTxFunc != nil {
+		return mock.SignTxFunc(tx, pk)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) SendEth(fromAddress common.Address, toAddressString string, value string, pk string) (r0 string, r1 *big.Int, r2 error) {
+	mock.record("SendEth", fromAddress, toAddressString, value, pk)
+	if mock.SendEthFunc != nil {
+		return mock.SendEthFunc(fromAddress, toAddressString, value, pk)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) SignAndSend(toAddressString string, value *big.Int, data []byte, nonce *big.Int, customGasPrice interface{}, customGasLimit interface{}, pk string) (r0 *web3helper.TxResult, r1 error) {
+	mock.record("SignAndSend", toAddressString, value, data, nonce, customGasPrice, customGasLimit, pk)
+	if mock.SignAndSendFunc != nil {
+		return mock.SignAndSendFunc(toAddressString, value, data, nonce, customGasPrice, customGasLimit, pk)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) SignAndSendTransaction(toAddressString string, value *big.Int, data []byte, nonce *big.Int, customGasPrice interface{}, customGasLimit interface{}, pk string) (r0 string, r1 *big.Int, r2 error) {
+	mock.record("SignAndSendTransaction", toAddressString, value, data, nonce, customGasPrice, customGasLimit, pk)
+	if mock.SignAndSendTransactionFunc != nil {
+		return mock.SignAndSendTransactionFunc(toAddressString, value, data, nonce, customGasPrice, customGasLimit, pk)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) CancelTx(to string, nonce *big.Int, multiplier int64, pk string) (r0 string, r1 error) {
+	mock.record("CancelTx", to, nonce, multiplier, pk)
+	if mock.CancelTxFunc != nil {
+		return mock.CancelTxFunc(to, nonce, multiplier, pk)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) TransactionStatus(ctx context.Context, txHash string) (r0 *web3helper.TxStatus, r1 error) {
+	mock.record("TransactionStatus", ctx, txHash)
+	if mock.TransactionStatusFunc != nil {
+		return mock.TransactionStatusFunc(ctx, txHash)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) SpeedUpTransaction(ctx context.Context, txHash string, bumpPercent int64, pk string) (r0 *types.Transaction, r1 error) {
+	mock.record("SpeedUpTransaction", ctx, txHash, bumpPercent, pk)
+	if mock.SpeedUpTransactionFunc != nil {
+		return mock.SpeedUpTransactionFunc(ctx, txHash, bumpPercent, pk)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) CancelPendingTransaction(ctx context.Context, txHash string, bumpPercent int64, pk string) (r0 *types.Transaction, r1 error) {
+	mock.record("CancelPendingTransaction", ctx, txHash, bumpPercent, pk)
+	if mock.CancelPendingTransactionFunc != nil {
+		return mock.CancelPendingTransactionFunc(ctx, txHash, bumpPercent, pk)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) CancelTransaction(ctx context.Context, transaction *types.Transaction, privateKey *ecdsa.PrivateKey) (r0 *types.Transaction, r1 error) {
+	mock.record("CancelTransaction", ctx, transaction, privateKey)
+	if mock.CancelTransactionFunc != nil {
+		return mock.CancelTransactionFunc(ctx, transaction, privateKey)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) OnTransactionSent(hook web3helper.TxSentHook) {
+	mock.record("OnTransactionSent", hook)
+	if mock.OnTransactionSentFunc != nil {
+		mock.OnTransactionSentFunc(hook)
+	}
+}
+
+func (mock *MockWeb3Helper) TokenInfo(ctx context.Context, tokenAddress string) (r0 *web3helper.TokenInfo, r1 error) {
+	mock.record("TokenInfo", ctx, tokenAddress)
+	if mock.TokenInfoFunc != nil {
+		return mock.TokenInfoFunc(ctx, tokenAddress)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) TokenBalance(ctx context.Context, tokenAddress string, owner string) (r0 *big.Int, r1 error) {
+	mock.record("TokenBalance", ctx, tokenAddress, owner)
+	if mock.TokenBalanceFunc != nil {
+		return mock.TokenBalanceFunc(ctx, tokenAddress, owner)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) TokenAllowance(ctx context.Context, tokenAddress string, owner string, spender string) (r0 *big.Int, r1 error) {
+	mock.record("TokenAllowance", ctx, tokenAddress, owner, spender)
+	if mock.TokenAllowanceFunc != nil {
+		return mock.TokenAllowanceFunc(ctx, tokenAddress, owner, spender)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) TransferToken(ctx context.Context, tokenAddress string, toAddress string, amount *big.Int, pk string) (r0 *types.Transaction, r1 error) {
+	mock.record("TransferToken", ctx, tokenAddress, toAddress, amount, pk)
+	if mock.TransferTokenFunc != nil {
+		return mock.TransferTokenFunc(ctx, tokenAddress, toAddress, amount, pk)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) ApproveToken(ctx context.Context, tokenAddress string, spender string, amount *big.Int, pk string) (r0 *types.Transaction, r1 error) {
+	mock.record("ApproveToken", ctx, tokenAddress, spender, amount, pk)
+	if mock.ApproveTokenFunc != nil {
+		return mock.ApproveTokenFunc(ctx, tokenAddress, spender, amount, pk)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) SendTokens(tokenAddressString string, toAddressString string, value *big.Int, pk string) (r0 string, r1 *big.Int, r2 error) {
+	mock.record("SendTokens", tokenAddressString, toAddressString, value, pk)
+	if mock.SendTokensFunc != nil {
+		return mock.SendTokensFunc(tokenAddressString, toAddressString, value, pk)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) QuoteSwap(ctx context.Context, router common.Address, amountIn *big.Int, path []common.Address) (r0 []*big.Int, r1 error) {
+	mock.record("QuoteSwap", ctx, router, amountIn, path)
+	if mock.QuoteSwapFunc != nil {
+		return mock.QuoteSwapFunc(ctx, router, amountIn, path)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) SwapExactETHForTokens(ctx context.Context, router common.Address, amountIn *big.Int, amountOutMin *big.Int, path []common.Address, to common.Address, deadline *big.Int, pk string) (r0 *types.Transaction, r1 error) {
+	mock.record("SwapExactETHForTokens", ctx, router, amountIn, amountOutMin, path, to, deadline, pk)
+	if mock.SwapExactETHForTokensFunc != nil {
+		return mock.SwapExactETHForTokensFunc(ctx, router, amountIn, amountOutMin, path, to, deadline, pk)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) SwapExactTokensForETH(ctx context.Context, router common.Address, amountIn *big.Int, amountOutMin *big.Int, path []common.Address, to common.Address, deadline *big.Int, pk string) (r0 *types.Transaction, r1 error) {
+	mock.record("SwapExactTokensForETH", ctx, router, amountIn, amountOutMin, path, to, deadline, pk)
+	if mock.SwapExactTokensForETHFunc != nil {
+		return mock.SwapExactTokensForETHFunc(ctx, router, amountIn, amountOutMin, path, to, deadline, pk)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) GetPairAddress(ctx context.Context, factory common.Address, tokenA common.Address, tokenB common.Address) (r0 common.Address, r1 error) {
+	mock.record("GetPairAddress", ctx, factory, tokenA, tokenB)
+	if mock.GetPairAddressFunc != nil {
+		return mock.GetPairAddressFunc(ctx, factory, tokenA, tokenB)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) GetPairReserves(ctx context.Context, pair common.Address) (r0 *web3helper.PairReserves, r1 error) {
+	mock.record("GetPairReserves", ctx, pair)
+	if mock.GetPairReservesFunc != nil {
+		return mock.GetPairReservesFunc(ctx, pair)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) Buy(fromAddress common.Address, tokenAddress string, bnbAmount float64, pk string) (r0 string, r1 error) {
+	mock.record("Buy", fromAddress, tokenAddress, bnbAmount, pk)
+	if mock.BuyFunc != nil {
+		return mock.BuyFunc(fromAddress, tokenAddress, bnbAmount, pk)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) GetReserves(pairAddress string) (r0 web3helper.Reserve) {
+	mock.record("GetReserves", pairAddress)
+	if mock.GetReservesFunc != nil {
+		return mock.GetReservesFunc(pairAddress)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) GetPair(tokenAddress string) (r0 string, r1 error) {
+	mock.record("GetPair", tokenAddress)
+	if mock.GetPairFunc != nil {
+		return mock.GetPairFunc(tokenAddress)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) BindContract(address common.Address, abiJSON string) (r0 *web3helper.Contract, r1 error) {
+	mock.record("BindContract", address, abiJSON)
+	if mock.BindContractFunc != nil {
+		return mock.BindContractFunc(address, abiJSON)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) BindContractFile(address common.Address, path string) (r0 *web3helper.Contract, r1 error) {
+	mock.record("BindContractFile", address, path)
+	if mock.BindContractFileFunc != nil {
+		return mock.BindContractFileFunc(address, path)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) Simulate(ctx context.Context, msg ethereum.CallMsg) (r0 []byte, r1 error) {
+	mock.record("Simulate", ctx, msg)
+	if mock.SimulateFunc != nil {
+		return mock.SimulateFunc(ctx, msg)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) DecodeRevert(err error) (r0 *web3helper.RevertError) {
+	mock.record("DecodeRevert", err)
+	if mock.DecodeRevertFunc != nil {
+		return mock.DecodeRevertFunc(err)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) DecodeRevertData(data []byte) (r0 *web3helper.RevertError) {
+	mock.record("DecodeRevertData", data)
+	if mock.DecodeRevertDataFunc != nil {
+		return mock.DecodeRevertDataFunc(data)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) RegisterErrorABI(abiJSON string) (r0 error) {
+	mock.record("RegisterErrorABI", abiJSON)
+	if mock.RegisterErrorABIFunc != nil {
+		return mock.RegisterErrorABIFunc(abiJSON)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) TransactionRevert(ctx context.Context, txHash string) (r0 *web3helper.RevertError, r1 error) {
+	mock.record("TransactionRevert", ctx, txHash)
+	if mock.TransactionRevertFunc != nil {
+		return mock.TransactionRevertFunc(ctx, txHash)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) TypedDataDomain(ctx context.Context, name string, version string, verifyingContract common.Address) (r0 web3helper.TypedDataDomain, r1 error) {
+	mock.record("TypedDataDomain", ctx, name, version, verifyingContract)
+	if mock.TypedDataDomainFunc != nil {
+		return mock.TypedDataDomainFunc(ctx, name, version, verifyingContract)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) SubscribeNewBlocks(ctx context.Context, opts web3helper.BlockSubscriptionOptions, out chan<- *web3helper.BlockEvent) (r0 ethereum.Subscription, r1 error) {
+	mock.record("SubscribeNewBlocks", ctx, opts, out)
+	if mock.SubscribeNewBlocksFunc != nil {
+		return mock.SubscribeNewBlocksFunc(ctx, opts, out)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) SubscribePendingTransactions(ctx context.Context, filter web3helper.PendingTxFilter, out chan<- *types.Transaction) (r0 ethereum.Subscription, r1 error) {
+	mock.record("SubscribePendingTransactions", ctx, filter, out)
+	if mock.SubscribePendingTransactionsFunc != nil {
+		return mock.SubscribePendingTransactionsFunc(ctx, filter, out)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) ListenBridgesEventsV2(contractsAddresses []string, out chan<- types.Log) (r0 ethereum.Subscription, r1 error) {
+	mock.record("ListenBridgesEventsV2", contractsAddresses, out)
+	if mock.ListenBridgesEventsV2Func != nil {
+		return mock.ListenBridgesEventsV2Func(contractsAddresses, out)
+	}
+	return
+}
+
+func (mock *MockWeb3Helper) GenerateContractEventSubscription(contractAddress string) (r0 chan types.Log, r1 ethereum.Subscription, r2 error) {
+	mock.record("GenerateContractEventSubscription", contractAddress)
+	if mock.GenerateContractEventSubscriptionFunc != nil {
+		return mock.GenerateContractEventSubscriptionFunc(contractAddress)
+	}
+	return
+}